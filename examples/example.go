@@ -4,26 +4,32 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
 )
 
 func main() {
-	client, err := client.NewClient(context.TODO(), "https://gocd.8x8.com/go")
+	client, err := client.NewClient(
+		"https://gocd.8x8.com/go",
+		client.WithAccessToken("<YOUR_ACCESS_TOKEN>"),
+		// client.WithBasicAuth("<USERNAME>", "<PASSWORD>"),
+		client.WithTimeout(30*time.Second),
+	)
 	if err != nil {
 		fmt.Printf("failed to create client: '%s'\n", err.Error())
 		os.Exit(1)
 	}
 
-	client.SetAccessToken("<YOUR_ACCESS_TOKEN>")
-	// client.SetBasicAuth("<USERNAME>", "<PASSWORD>")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 
-	version, err := client.GetVersion()
+	version, err := client.GetVersion(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
 
-	currentUser, err := client.GetCurrentUser()
+	currentUser, err := client.GetCurrentUser(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
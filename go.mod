@@ -2,15 +2,10 @@ module github.com/AlinScreciu/gocd-go-api-client
 
 go 1.21.5
 
-require (
-	github.com/antonfisher/nested-logrus-formatter v1.3.1
-	github.com/sirupsen/logrus v1.9.3
-	github.com/stretchr/testify v1.7.0
-)
+require github.com/stretchr/testify v1.7.0
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
 	gopkg.in/yaml.v3 v3.0.0 // indirect
 )
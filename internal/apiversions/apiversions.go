@@ -0,0 +1,254 @@
+package apiversions
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+)
+
+// Support is an Accept version served by GoCD releases in [Since, Until), Until is empty
+// while the version is still served.
+type Support struct {
+	Accept string
+	Since  string
+	Until  string
+}
+
+// Endpoint lists the Accept versions of an API path, ":name" segments match any value.
+type Endpoint struct {
+	Pattern  string
+	Versions []Support
+}
+
+var packages = []Support{
+	{Accept: constants.AcceptV1, Since: "16.12.0", Until: "20.1.0"},
+	{Accept: constants.AcceptV2, Since: "19.3.0"},
+}
+
+var pipelineConfigs = []Support{
+	{Accept: constants.AcceptV10, Since: "20.1.0", Until: "21.1.0"},
+	{Accept: constants.AcceptV11, Since: "20.8.0"},
+}
+
+var pipelineOperations = []Support{
+	{Accept: constants.AcceptV1, Since: "18.2.0"},
+}
+
+var pipelineInstances = []Support{
+	{Accept: constants.AcceptV1, Since: "20.1.0"},
+}
+
+var stageInstances = []Support{
+	{Accept: constants.AcceptV2, Since: "19.8.0", Until: "20.1.0"},
+	{Accept: constants.AcceptV3, Since: "20.1.0"},
+}
+
+var stageOperations = []Support{
+	{Accept: constants.AcceptV3, Since: "20.1.0"},
+}
+
+var pipelineGroups = []Support{
+	{Accept: constants.AcceptV1, Since: "19.2.0"},
+}
+
+var templates = []Support{
+	{Accept: constants.AcceptV7, Since: "20.8.0"},
+}
+
+var templateAuthorization = []Support{
+	{Accept: constants.AcceptV1, Since: "19.1.0"},
+}
+
+var pipelineExport = []Support{
+	{Accept: constants.AcceptV1, Since: "19.3.0"},
+}
+
+var dashboard = []Support{
+	{Accept: constants.AcceptV3, Since: "19.1.0", Until: "20.1.0"},
+	{Accept: constants.AcceptV4, Since: "20.1.0"},
+}
+
+var pipelineComparison = []Support{
+	{Accept: constants.AcceptV1, Since: "19.5.0", Until: "20.2.0"},
+	{Accept: constants.AcceptV2, Since: "20.2.0"},
+}
+
+var jobInstances = []Support{
+	{Accept: constants.AcceptV1, Since: "20.1.0"},
+}
+
+var endpoints = []Endpoint{
+	{
+		Pattern:  "/api/version",
+		Versions: []Support{{Accept: constants.AcceptV1, Since: "16.6.0"}},
+	},
+	{
+		Pattern:  "/api/current_user",
+		Versions: []Support{{Accept: constants.AcceptV1, Since: "17.5.0"}},
+	},
+	{Pattern: "/api/admin/packages", Versions: packages},
+	{Pattern: "/api/admin/packages/:package_id", Versions: packages},
+	{Pattern: "/api/admin/pipelines", Versions: pipelineConfigs},
+	{Pattern: "/api/admin/pipelines/:pipeline_name", Versions: pipelineConfigs},
+	{Pattern: "/api/admin/pipeline_groups", Versions: pipelineGroups},
+	{Pattern: "/api/admin/pipeline_groups/:group_name", Versions: pipelineGroups},
+	{Pattern: "/api/admin/export/pipelines/:pipeline_name", Versions: pipelineExport},
+	{Pattern: "/api/admin/templates", Versions: templates},
+	{Pattern: "/api/admin/templates/:template_name", Versions: templates},
+	{Pattern: "/api/admin/templates/:template_name/authorization", Versions: templateAuthorization},
+	{Pattern: "/api/pipelines/:pipeline_name/pause", Versions: pipelineOperations},
+	{Pattern: "/api/pipelines/:pipeline_name/unpause", Versions: pipelineOperations},
+	{Pattern: "/api/pipelines/:pipeline_name/unlock", Versions: pipelineOperations},
+	{Pattern: "/api/pipelines/:pipeline_name/schedule", Versions: pipelineOperations},
+	{
+		Pattern:  "/api/pipelines/:pipeline_name/status",
+		Versions: []Support{{Accept: constants.AcceptV1, Since: "18.7.0"}},
+	},
+	{Pattern: "/api/pipelines/:pipeline_name/history", Versions: pipelineInstances},
+	{Pattern: "/api/pipelines/:pipeline_name/compare/:from_counter/:to_counter", Versions: pipelineComparison},
+	{Pattern: "/api/pipelines/:pipeline_name/:pipeline_counter", Versions: pipelineInstances},
+	{
+		Pattern:  "/api/stages/:pipeline_name/:pipeline_counter/:stage_name/run",
+		Versions: []Support{{Accept: constants.AcceptV2, Since: "19.8.0"}},
+	},
+	{Pattern: "/api/stages/:pipeline_name/:pipeline_counter/:stage_name/:stage_counter/cancel", Versions: stageOperations},
+	{Pattern: "/api/stages/:pipeline_name/:pipeline_counter/:stage_name/:stage_counter/run-failed-jobs", Versions: stageOperations},
+	{Pattern: "/api/stages/:pipeline_name/:pipeline_counter/:stage_name/:stage_counter/run-selected-jobs", Versions: stageOperations},
+	{Pattern: "/api/stages/:pipeline_name/:stage_name/history", Versions: stageInstances},
+	{Pattern: "/api/stages/:pipeline_name/:pipeline_counter/:stage_name/:stage_counter", Versions: stageInstances},
+	{Pattern: "/api/dashboard", Versions: dashboard},
+	{Pattern: "/api/jobs/:pipeline_name/:stage_name/:job_name/history", Versions: jobInstances},
+	{Pattern: "/api/jobs/:pipeline_name/:pipeline_counter/:stage_name/:stage_counter/:job_name", Versions: jobInstances},
+}
+
+func match(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if !strings.HasPrefix(segment, ":") && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func Lookup(endpoint string) (Endpoint, bool) {
+	path, _, _ := strings.Cut(endpoint, "?")
+
+	for _, e := range endpoints {
+		if match(e.Pattern, path) {
+			return e, true
+		}
+	}
+
+	return Endpoint{}, false
+}
+
+// Release is a parsed GoCD version, e.g. "23.1.0".
+type Release [3]int
+
+func ParseRelease(version string) (Release, bool) {
+	var r Release
+
+	version, _, _ = strings.Cut(strings.TrimSpace(version), " ")
+
+	parts := strings.Split(version, ".")
+	if len(parts) == 0 || len(parts) > len(r) {
+		return r, false
+	}
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return r, false
+		}
+
+		r[i] = n
+	}
+
+	return r, true
+}
+
+func (r Release) Less(other Release) bool {
+	for i := range r {
+		if r[i] != other[i] {
+			return r[i] < other[i]
+		}
+	}
+
+	return false
+}
+
+func (s Support) servedBy(release Release) bool {
+	if since, ok := ParseRelease(s.Since); ok && release.Less(since) {
+		return false
+	}
+
+	if until, ok := ParseRelease(s.Until); ok && !release.Less(until) {
+		return false
+	}
+
+	return true
+}
+
+func acceptVersion(accept string) int {
+	version := strings.TrimSuffix(strings.TrimPrefix(accept, "application/vnd.go.cd.v"), "+json")
+
+	n, err := strconv.Atoi(version)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
+// Older reports whether accept is a lower API version than other, both being versioned
+// Accept headers.
+func Older(accept, other string) bool {
+	v := acceptVersion(accept)
+
+	return v > 0 && v < acceptVersion(other)
+}
+
+// Candidates returns the Accept headers to try, best first. With a known server release
+// only the versions it serves are returned, newest first; otherwise the preferred version
+// comes first and the remaining ones are kept as fallbacks.
+func (e Endpoint) Candidates(preferred string, release *Release) []string {
+	var candidates []string
+
+	if release == nil && acceptVersion(preferred) > 0 {
+		candidates = append(candidates, preferred)
+	}
+
+	for _, s := range e.Versions {
+		if release == nil && s.Accept == preferred {
+			continue
+		}
+
+		if release == nil || s.servedBy(*release) {
+			candidates = append(candidates, s.Accept)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if release == nil && (candidates[i] == preferred) != (candidates[j] == preferred) {
+			return candidates[i] == preferred
+		}
+
+		return acceptVersion(candidates[i]) > acceptVersion(candidates[j])
+	})
+
+	if len(candidates) == 0 {
+		return []string{preferred}
+	}
+
+	return candidates
+}
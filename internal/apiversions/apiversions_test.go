@@ -0,0 +1,91 @@
+package apiversions
+
+import (
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelease(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		version string
+		want    Release
+		wantOk  bool
+	}{
+		{version: "23.1.0", want: Release{23, 1, 0}, wantOk: true},
+		{version: "16.6.0 (3348-a7a5717cbd60c30006314fb8dd529796c93adaf0)", want: Release{16, 6, 0}, wantOk: true},
+		{version: "19.3", want: Release{19, 3, 0}, wantOk: true},
+		{version: "", wantOk: false},
+		{version: "latest", wantOk: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+			got, ok := ParseRelease(tt.version)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	t.Parallel()
+
+	release := func(version string) *Release {
+		r, ok := ParseRelease(version)
+		require.True(t, ok)
+
+		return &r
+	}
+
+	endpoint, ok := Lookup("/api/admin/packages/my-package?foo=bar")
+	require.True(t, ok)
+	assert.Equal(t, "/api/admin/packages/:package_id", endpoint.Pattern)
+
+	_, ok = Lookup("/api/admin/packages/my-package/extra")
+	assert.False(t, ok)
+
+	tests := []struct {
+		name      string
+		preferred string
+		release   *Release
+		want      []string
+	}{
+		{
+			name:      "Unknown release keeps the preferred version first",
+			preferred: constants.AcceptV1,
+			want:      []string{constants.AcceptV1, constants.AcceptV2},
+		},
+		{
+			name:      "Old release only gets the versions it serves",
+			preferred: constants.AcceptV2,
+			release:   release("18.1.0"),
+			want:      []string{constants.AcceptV1},
+		},
+		{
+			name:      "Transition release prefers the newest version",
+			preferred: constants.AcceptV1,
+			release:   release("19.5.0"),
+			want:      []string{constants.AcceptV2, constants.AcceptV1},
+		},
+		{
+			name:      "New release",
+			preferred: constants.AcceptV2,
+			release:   release("23.1.0"),
+			want:      []string{constants.AcceptV2},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, endpoint.Candidates(tt.preferred, tt.release))
+		})
+	}
+}
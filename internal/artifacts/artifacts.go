@@ -0,0 +1,174 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // GoCD records MD5 checksums, they only detect corruption
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint            = "/files"
+	checksumPath        = "cruise-output/md5.checksum"
+	defaultPollInterval = 2 * time.Second
+)
+
+var (
+	ErrChecksumMismatch = errors.New("artifact checksum mismatch")
+	ErrChecksumMissing  = errors.New("artifact checksum missing")
+)
+
+func jobEndpoint(pipeline string, pipelineCounter int, stage string, stageCounter int, job string) string {
+	return endpoint + "/" + url.PathEscape(pipeline) + "/" + strconv.Itoa(pipelineCounter) + "/" +
+		url.PathEscape(stage) + "/" + strconv.Itoa(stageCounter) + "/" + url.PathEscape(job)
+}
+
+// artifactEndpoint escapes every segment of the slash separated path of an artifact.
+func artifactEndpoint(job, path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return job + "/" + strings.Join(segments, "/")
+}
+
+func GetArtifacts(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) ([]types.ArtifactNode, error) {
+	nodes, err := client.Get[[]types.ArtifactNode](ctx, c, jobEndpoint(pipeline, pipelineCounter, stage, stageCounter, job)+".json", "application/json", "artifacts")
+	if err != nil {
+		return nil, err
+	}
+
+	return *nodes, nil
+}
+
+// DownloadArtifact copies the file at path to w as it is received and returns the number
+// of bytes written. With VerifyChecksum the bytes are already written when a mismatch is
+// reported, the caller has to discard them.
+func DownloadArtifact(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error) {
+	jobEndpoint := jobEndpoint(pipeline, pipelineCounter, stage, stageCounter, job)
+	verify := opts != nil && opts.VerifyChecksum
+
+	var want string
+
+	// the checksum is fetched first, a missing one fails before anything is written
+	if verify {
+		checksums, err := getChecksums(ctx, c, jobEndpoint)
+		if err != nil {
+			return 0, err
+		}
+
+		var ok bool
+
+		want, ok = checksums[strings.Trim(path, "/")]
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", ErrChecksumMissing, path)
+		}
+	}
+
+	res, err := client.Stream(ctx, c, &client.Request{
+		Method:   http.MethodGet,
+		Endpoint: artifactEndpoint(jobEndpoint, path),
+		Module:   "artifacts",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	hash := md5.New() //nolint:gosec // see import
+
+	n, err := io.Copy(io.MultiWriter(w, hash), res.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to download artifact '%s': '%w'", path, err)
+	}
+
+	if verify {
+		got := hex.EncodeToString(hash.Sum(nil))
+		if !strings.EqualFold(got, want) {
+			return n, fmt.Errorf("%w: %s: got %s, want %s", ErrChecksumMismatch, path, got, want)
+		}
+	}
+
+	return n, nil
+}
+
+// DownloadArtifactDirectory copies the directory at path to w as a zip archive. GoCD
+// answers 202 Accepted while it builds the archive, the request is repeated until it is
+// ready or ctx is done. Checksums are not verified, GoCD does not record any for archives.
+func DownloadArtifactDirectory(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error) {
+	interval := defaultPollInterval
+	if opts != nil && opts.PollInterval > 0 {
+		interval = opts.PollInterval
+	}
+
+	r := &client.Request{
+		Method:   http.MethodGet,
+		Endpoint: artifactEndpoint(jobEndpoint(pipeline, pipelineCounter, stage, stageCounter, job), path) + ".zip",
+		Module:   "artifacts",
+	}
+
+	for {
+		res, err := client.Stream(ctx, c, r)
+		if err != nil {
+			return 0, err
+		}
+
+		if res.StatusCode == http.StatusAccepted {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+
+			err = client.Sleep(ctx, interval)
+			if err != nil {
+				return 0, err
+			}
+
+			continue
+		}
+
+		n, err := io.Copy(w, res.Body)
+		res.Body.Close()
+
+		if err != nil {
+			return n, fmt.Errorf("failed to download artifact directory '%s': '%w'", path, err)
+		}
+
+		return n, nil
+	}
+}
+
+// getChecksums returns the MD5 checksums GoCD recorded for the artifacts of the job, by path.
+func getChecksums(ctx context.Context, c *client.Client, jobEndpoint string) (map[string]string, error) {
+	res, err := client.Stream(ctx, c, &client.Request{
+		Method:   http.MethodGet,
+		Endpoint: artifactEndpoint(jobEndpoint, checksumPath),
+		Module:   "artifacts",
+	})
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: job has no %s", ErrChecksumMissing, checksumPath)
+		}
+
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	checksums, err := parseProperties(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: '%w'", checksumPath, err)
+	}
+
+	return checksums, nil
+}
@@ -0,0 +1,284 @@
+package artifacts
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jobPath = "/files/build/12/package/1/jar"
+
+func newClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	url, _ := url.Parse(ts.URL)
+
+	return client.NewClient(url)
+}
+
+func TestGetArtifacts(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, jobPath+".json", r.URL.Path)
+		_, _ = w.Write([]byte(`[
+			{"name": "dist", "url": "http://gocd/go/files/build/12/package/1/jar/dist", "type": "folder", "files": [
+				{"name": "app.jar", "url": "http://gocd/go/files/build/12/package/1/jar/dist/app.jar", "type": "file"}
+			]}
+		]`))
+	})
+
+	nodes, err := GetArtifacts(context.TODO(), c, "build", 12, "package", 1, "jar")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, types.ArtifactNodeFolder, nodes[0].Type)
+	require.Len(t, nodes[0].Files, 1)
+	assert.Equal(t, "app.jar", nodes[0].Files[0].Name)
+}
+
+func TestDownloadArtifact(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		checksums string
+		verify    bool
+		wantErr   error
+	}{
+		{
+			name: "Without verification",
+		},
+		{
+			name:      "Matching checksum",
+			checksums: "dist/app\\ v1.jar=9a0364b9e99bb480dd25e1f0284c8555\n",
+			verify:    true,
+		},
+		{
+			name:      "Different checksum",
+			checksums: "dist/app\\ v1.jar=00000000000000000000000000000000\n",
+			verify:    true,
+			wantErr:   ErrChecksumMismatch,
+		},
+		{
+			name:      "Missing checksum",
+			checksums: "dist/other.jar=9a0364b9e99bb480dd25e1f0284c8555\n",
+			verify:    true,
+			wantErr:   ErrChecksumMissing,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case jobPath + "/cruise-output/md5.checksum":
+					_, _ = w.Write([]byte("#Fri Nov 04 10:00:00 UTC 2022\n" + tt.checksums))
+				case jobPath + "/dist/app v1.jar":
+					_, _ = w.Write([]byte("content"))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			})
+
+			var buf bytes.Buffer
+			n, err := DownloadArtifact(context.TODO(), c, "build", 12, "package", 1, "jar", "dist/app v1.jar", &buf, &types.ArtifactDownloadOptions{VerifyChecksum: tt.verify})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(7), n)
+			assert.Equal(t, "content", buf.String())
+		})
+	}
+}
+
+func TestDownloadArtifactDirectory(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, jobPath+"/dist.zip", r.URL.Path)
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusAccepted)
+
+			return
+		}
+		_, _ = w.Write([]byte("PK zip"))
+	})
+
+	var buf bytes.Buffer
+	_, err := DownloadArtifactDirectory(context.TODO(), c, "build", 12, "package", 1, "jar", "dist", &buf, &types.ArtifactDownloadOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, "PK zip", buf.String())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestUploadArtifact(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, jobPath+"/dist", r.URL.Path)
+		assert.Equal(t, "true", r.Header.Get("X-Gocd-Confirm"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		assert.Equal(t, "app.jar", header.Filename)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "content", string(content))
+
+		checksums, _, err := r.FormFile("file_checksum")
+		require.NoError(t, err)
+		content, _ = io.ReadAll(checksums)
+		assert.Equal(t, "dist/app.jar=9a0364b9e99bb480dd25e1f0284c8555\n", string(content))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("File dist/app.jar was created successfully\n"))
+	})
+
+	message, err := UploadArtifact(context.TODO(), c, "build", 12, "package", 1, "jar", "dist", "app.jar", strings.NewReader("content"))
+	require.NoError(t, err)
+	assert.Equal(t, "File dist/app.jar was created successfully", message)
+}
+
+func TestUploadArtifactDirectory(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("zipfile")
+		require.NoError(t, err)
+		content, _ := io.ReadAll(file)
+
+		archive, err := zip.NewReader(bytes.NewReader(content), header.Size)
+		require.NoError(t, err)
+		var names []string
+		for _, f := range archive.File {
+			names = append(names, f.Name)
+		}
+		assert.Equal(t, []string{"app.jar", "lib/dep.jar"}, names)
+
+		checksums, _, err := r.FormFile("file_checksum")
+		require.NoError(t, err)
+		content, _ = io.ReadAll(checksums)
+		assert.Equal(t, "dist/app.jar=9a0364b9e99bb480dd25e1f0284c8555\ndist/lib/dep.jar=d41d8cd98f00b204e9800998ecf8427e\n", string(content))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	dir := fstest.MapFS{
+		"app.jar":     {Data: []byte("content")},
+		"lib/dep.jar": {Data: []byte{}},
+	}
+
+	_, err := UploadArtifactDirectory(context.TODO(), c, "build", 12, "package", 1, "jar", "/dist/", dir)
+	require.NoError(t, err)
+}
+
+func TestUploadArtifactFailure(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := UploadArtifact(context.TODO(), c, "build", 12, "package", 1, "jar", "dist", "app.jar", strings.NewReader("content"))
+	require.ErrorIs(t, err, client.ErrForbidden)
+}
+
+// endlessReader never runs out of content and counts the reads made once stopped is set.
+type endlessReader struct {
+	stopped   atomic.Bool
+	lateReads atomic.Int32
+}
+
+func (r *endlessReader) Read(p []byte) (int, error) {
+	if r.stopped.Load() {
+		r.lateReads.Add(1)
+	}
+
+	return len(p), nil
+}
+
+func TestUploadArtifactWaitsForWriter(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	content := &endlessReader{}
+	_, err := UploadArtifact(context.TODO(), c, "build", 12, "package", 1, "jar", "dist", "app.jar", content)
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	content.stopped.Store(true)
+	time.Sleep(20 * time.Millisecond)
+	assert.Zero(t, content.lateReads.Load())
+}
+
+func TestUploadArtifactReadError(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	errRead := errors.New("disk unplugged")
+	_, err := UploadArtifact(context.TODO(), c, "build", 12, "package", 1, "jar", "dist", "app.jar", iotest.ErrReader(errRead))
+	require.ErrorIs(t, err, errRead)
+}
+
+func TestAppendArtifactNotRetried(t *testing.T) {
+	t.Parallel()
+
+	var appended bytes.Buffer
+	var calls atomic.Int32
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, http.MethodPut, r.Method)
+		_, _ = io.Copy(&appended, r.Body)
+
+		// the data reached the server before it failed, sending it again would append it twice
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	policy := client.DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	c.Retry = &policy
+
+	_, err := AppendArtifact(context.TODO(), c, "build", 12, "package", 1, "jar", "logs/out.log", strings.NewReader("line\n"))
+	require.ErrorIs(t, err, client.ErrServerError)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "line\n", appended.String())
+}
+
+func TestParseProperties(t *testing.T) {
+	t.Parallel()
+
+	properties, err := parseProperties(strings.NewReader("# comment\n! comment\n\na=1\nb : 2\nc\\:d=3\n  e\\ f 4\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2", "c:d": "3", "e f": "4"}, properties)
+}
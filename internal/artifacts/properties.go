@@ -0,0 +1,92 @@
+package artifacts
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// parseProperties reads the Java properties format GoCD stores checksums in, one
+// key=value pair per line. Line continuations are not supported, checksum files have none.
+func parseProperties(r io.Reader) (map[string]string, error) {
+	properties := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		separator := len(line)
+
+		for i := 0; i < len(line); i++ {
+			if line[i] == '\\' {
+				i++
+
+				continue
+			}
+
+			if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+				separator = i
+
+				break
+			}
+		}
+
+		key := unescapeProperty(line[:separator])
+		value := ""
+
+		if separator < len(line) {
+			value = strings.TrimLeft(line[separator:], " \t\f")
+			if value != "" && (value[0] == '=' || value[0] == ':') {
+				value = strings.TrimLeft(value[1:], " \t\f")
+			}
+		}
+
+		properties[key] = unescapeProperty(value)
+	}
+
+	return properties, scanner.Err()
+}
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+
+		sb.WriteByte(s[i])
+	}
+
+	return sb.String()
+}
+
+var propertyKeyEscaper = strings.NewReplacer(`\`, `\\`, "=", `\=`, ":", `\:`, " ", `\ `, "#", `\#`, "!", `\!`)
+
+// writeProperties writes the properties sorted by key, so the output is stable.
+func writeProperties(w io.Writer, properties map[string]string) error {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		_, err := fmt.Fprintf(w, "%s=%s\n", propertyKeyEscaper.Replace(key), properties[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package artifacts
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/md5" //nolint:gosec // see artifacts.go
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+)
+
+// upload streams the multipart form built by write to endpoint, it is generated while the
+// request is sent and never held in memory as a whole. It returns only once write is done,
+// the content it reads is then no longer in use.
+func upload(ctx context.Context, c *client.Client, endpoint string, write func(*multipart.Writer) error) (string, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	written := make(chan error, 1)
+
+	go func() {
+		err := write(mw)
+		if err == nil {
+			err = mw.Close()
+		}
+
+		pw.CloseWithError(err)
+		written <- err
+	}()
+
+	res, err := client.Do(ctx, c, &client.Request{
+		Method:      http.MethodPost,
+		Endpoint:    endpoint,
+		Module:      "artifacts",
+		Header:      http.Header{"X-Gocd-Confirm": []string{"true"}},
+		Reader:      pr,
+		ContentType: mw.FormDataContentType(),
+	})
+
+	// unblocks the writer when the request failed before its body was read
+	pr.Close()
+
+	// a form that could not be built is the cause of the failure, not the request it aborted
+	writeErr := <-written
+	if writeErr != nil && !errors.Is(writeErr, io.ErrClosedPipe) {
+		return "", writeErr
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(res.Body)), nil
+}
+
+// writeChecksums adds the file_checksum part GoCD merges into the md5.checksum of the job.
+func writeChecksums(mw *multipart.Writer, checksums map[string]string) error {
+	part, err := mw.CreateFormFile("file_checksum", "md5.checksum")
+	if err != nil {
+		return err
+	}
+
+	return writeProperties(part, checksums)
+}
+
+// UploadArtifact stores the content of r as the file name in the dest directory of the job
+// artifacts, along with its MD5 checksum.
+func UploadArtifact(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest, name string, r io.Reader) (string, error) {
+	e := artifactEndpoint(jobEndpoint(pipeline, pipelineCounter, stage, stageCounter, job), dest)
+
+	return upload(ctx, c, e, func(mw *multipart.Writer) error {
+		part, err := mw.CreateFormFile("file", name)
+		if err != nil {
+			return err
+		}
+
+		hash := md5.New() //nolint:gosec // see import
+
+		_, err = io.Copy(io.MultiWriter(part, hash), r)
+		if err != nil {
+			return fmt.Errorf("failed to read artifact '%s': '%w'", name, err)
+		}
+
+		return writeChecksums(mw, map[string]string{
+			path.Join(strings.Trim(dest, "/"), name): hex.EncodeToString(hash.Sum(nil)),
+		})
+	})
+}
+
+// UploadArtifactDirectory zips every file of dir, GoCD extracts the archive into the dest
+// directory of the job artifacts. The checksums are computed while zipping.
+func UploadArtifactDirectory(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest string, dir fs.FS) (string, error) {
+	e := artifactEndpoint(jobEndpoint(pipeline, pipelineCounter, stage, stageCounter, job), dest)
+
+	return upload(ctx, c, e, func(mw *multipart.Writer) error {
+		part, err := mw.CreateFormFile("zipfile", "artifacts.zip")
+		if err != nil {
+			return err
+		}
+
+		checksums := make(map[string]string)
+		zw := zip.NewWriter(part)
+
+		err = fs.WalkDir(dir, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil || !d.Type().IsRegular() {
+				return err
+			}
+
+			sum, err := zipFile(zw, dir, name)
+			if err != nil {
+				return fmt.Errorf("failed to zip artifact '%s': '%w'", name, err)
+			}
+
+			checksums[path.Join(strings.Trim(dest, "/"), name)] = sum
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = zw.Close()
+		if err != nil {
+			return err
+		}
+
+		return writeChecksums(mw, checksums)
+	})
+}
+
+// zipFile adds the file to the archive and returns its MD5 checksum.
+func zipFile(zw *zip.Writer, dir fs.FS, name string) (string, error) {
+	f, err := dir.Open(name)
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+	if err != nil {
+		return "", err
+	}
+
+	hash := md5.New() //nolint:gosec // see import
+
+	_, err = io.Copy(io.MultiWriter(w, hash), f)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// AppendArtifact appends the content of r to the file at path, creating it when needed,
+// the way agents append to the console log. GoCD records no checksum for appended files.
+func AppendArtifact(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, r io.Reader) (string, error) {
+	res, err := client.Do(ctx, c, &client.Request{
+		Method:      http.MethodPut,
+		Endpoint:    artifactEndpoint(jobEndpoint(pipeline, pipelineCounter, stage, stageCounter, job), path),
+		Module:      "artifacts",
+		Header:      http.Header{"X-Gocd-Confirm": []string{"true"}},
+		Reader:      r,
+		ContentType: "application/octet-stream",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(res.Body)), nil
+}
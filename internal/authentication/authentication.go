@@ -1,6 +1,8 @@
 package authentication
 
 import (
+	"context"
+
 	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
 	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
@@ -10,6 +12,6 @@ const (
 	endpoint = "/api/current_user"
 )
 
-func GetCurrentUser(c *client.Client) (*types.CurrentUser, error) {
-	return client.Get[types.CurrentUser](c, endpoint, constants.AcceptV1, "authentication")
+func GetCurrentUser(ctx context.Context, c *client.Client) (*types.CurrentUser, error) {
+	return client.Get[types.CurrentUser](ctx, c, endpoint, constants.AcceptV1, "authentication")
 }
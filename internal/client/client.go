@@ -9,11 +9,12 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/AlinScreciu/gocd-go-api-client/internal/apiversions"
 	"github.com/AlinScreciu/gocd-go-api-client/internal/logging"
-	"github.com/sirupsen/logrus"
 )
 
 type AuthType uint8
@@ -25,14 +26,27 @@ const (
 )
 
 type Client struct {
-	ctx        context.Context
 	ServerURL  *url.URL
 	HttpClient *http.Client
 	Debug      bool
-	auth       AuthType
-	user       string
-	password   string
-	token      string
+	Retry      *RetryPolicy
+	// ModifyAttempts bounds the read-modify-write cycles of Modify, 0 means 3.
+	ModifyAttempts int
+	UserAgent      string
+	Headers        http.Header
+	Logger         logging.Logger
+	// Middlewares run, in order, around every request made by the client.
+	Middlewares []Middleware
+	// Negotiate picks the Accept version of each endpoint from the server release,
+	// ServerVersion skips its detection through /api/version.
+	Negotiate     bool
+	ServerVersion string
+	versionMu     sync.Mutex
+	serverRelease *apiversions.Release
+	auth          AuthType
+	user          string
+	password      string
+	token         string
 }
 
 func (c *Client) SetBasicAuth(user, password string) {
@@ -49,13 +63,42 @@ func (c *Client) SetAccessToken(token string) {
 	c.token = token
 }
 
-func NewClient(ctx context.Context, server *url.URL) *Client {
+func NewClient(server *url.URL) *Client {
 	return &Client{
 		ServerURL: server,
 		HttpClient: &http.Client{
 			Timeout: time.Minute,
 		},
-		ctx: ctx,
+	}
+}
+
+var debugLogger = logging.NewDebugLogger()
+
+func (c *Client) logger() logging.Logger {
+	switch {
+	case c.Logger != nil:
+		return c.Logger
+	case c.Debug:
+		return debugLogger
+	default:
+		return logging.Nop()
+	}
+}
+
+func setDefaultHeaders(c *Client, req *http.Request) {
+	for key, values := range c.Headers {
+		// headers set by the request itself, e.g. Accept, take precedence
+		if req.Header.Get(key) != "" {
+			continue
+		}
+
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
 	}
 }
 
@@ -68,188 +111,168 @@ func setAuth(c *Client, req *http.Request) {
 	}
 }
 
-func Get[T any](c *Client, endpoint, accept, module string) (*T, error) {
-	url := c.ServerURL.String() + endpoint
+// Request describes a single call to the GoCD API, Endpoint is relative to the server URL.
+type Request struct {
+	Method   string
+	Endpoint string
+	Accept   string
+	Module   string
+	Header   http.Header
+	// Body is sent as JSON when not nil.
+	Body []byte
+	// Reader, when Body is nil, is streamed as the body with ContentType. Such requests
+	// are never retried, whatever the type of the reader.
+	Reader      io.Reader
+	ContentType string
+}
 
-	l := logging.NewLogger()
-	if c.Debug {
-		l.SetDebug()
-	}
-	logger := l.WithFields(logrus.Fields{
-		"METHOD": "GET",
-		"URL":    url,
-	})
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Request    *http.Request
+}
 
-	req, err := http.NewRequestWithContext(c.ctx, http.MethodGet, url, nil)
-	if err != nil {
-		logger.Errorf("failed to create request object, url: %s: '%s'", url, err.Error())
+func (c *Client) fail(r *Request, req *http.Request, err error) error {
+	logger := c.logger().With("module", r.Module, "method", r.Method, "url", c.ServerURL.String()+r.Endpoint)
 
-		return nil, fmt.Errorf("failed to create request object, url: %s: '%w'", url, err)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		logger.Error("unexpected response status", "status", apiErr.StatusCode, "message", apiErr.Message)
+	} else {
+		logger.Error("request failed", "error", err)
 	}
 
-	req.Header.Add("Accept", accept)
-
-	setAuth(c, req)
-
-	res, err := c.HttpClient.Do(req)
-	if err != nil {
-		logger.Errorf("%s", err.Error())
-
-		return nil, fmt.Errorf("request failed, url: %s: '%w'", url, err)
+	for _, mw := range c.Middlewares {
+		if mw.OnError != nil {
+			mw.OnError(req, err)
+		}
 	}
 
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		var sb strings.Builder
-
-		sb.WriteString(fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)))
-
-		if err == nil {
-			sb.WriteString(fmt.Sprintf(": '%s'", string(body)))
-		}
+	return err
+}
 
-		errMsg := sb.String()
+// roundTrip builds and sends the request, the caller owns the returned response body.
+func (c *Client) roundTrip(ctx context.Context, r *Request) (*http.Request, *http.Response, error) {
+	url := c.ServerURL.String() + r.Endpoint
 
-		logger.Error(errMsg)
+	var body io.Reader
 
-		return nil, errors.New(errMsg)
+	switch {
+	case r.Body != nil:
+		body = bytes.NewReader(r.Body)
+	case r.Reader != nil:
+		body = r.Reader
 	}
 
-	logger.Infof("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	req, err := http.NewRequestWithContext(ctx, r.Method, url, body)
 	if err != nil {
-		logger.Errorf("failed to read response body: '%s'", err.Error())
-
-		return nil, fmt.Errorf("failed to read response body: '%w'", err)
+		return nil, nil, fmt.Errorf("failed to create request object, url: %s: '%w'", url, err)
 	}
 
-	var t T
-
-	err = json.Unmarshal(body, &t)
-	if err != nil {
-		logger.Errorf("failed to parse response body: '%s'", err.Error())
-
-		return nil, fmt.Errorf("failed to parse response body: '%w'", err)
+	// net/http can rewind strings and bytes readers, a streamed body is still sent only once
+	if r.Body == nil && r.Reader != nil {
+		req.GetBody = nil
 	}
 
-	return &t, nil
-}
-
-func GetWithETag[T any](c *Client, endpoint, accept, module string) (*T, string, error) {
-	url := c.ServerURL.String() + endpoint
-
-	l := logging.NewLogger()
-	if c.Debug {
-		l.SetDebug()
+	if r.Accept != "" {
+		req.Header.Set("Accept", r.Accept)
 	}
-	logger := l.WithFields(logrus.Fields{
-		"METHOD": "GET",
-		"URL":    url,
-	})
 
-	req, err := http.NewRequestWithContext(c.ctx, http.MethodGet, url, nil)
-	if err != nil {
-		logger.Errorf("failed to create request object, url: %s: '%s'", url, err.Error())
-
-		return nil, "", fmt.Errorf("failed to create request object, url: %s: '%w'", url, err)
+	switch {
+	case r.Body != nil:
+		req.Header.Set("Content-Type", "application/json")
+	case r.Reader != nil && r.ContentType != "":
+		req.Header.Set("Content-Type", r.ContentType)
 	}
 
-	req.Header.Add("Accept", accept)
-
-	setAuth(c, req)
-
-	res, err := c.HttpClient.Do(req)
-	if err != nil {
-		logger.Errorf("%s", err.Error())
-
-		return nil, "", fmt.Errorf("request failed, url: %s: '%w'", url, err)
+	for key, values := range r.Header {
+		req.Header[key] = values
 	}
 
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		var sb strings.Builder
-
-		sb.WriteString(fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)))
+	setDefaultHeaders(c, req)
+	setAuth(c, req)
 
-		if err == nil {
-			sb.WriteString(fmt.Sprintf(": '%s'", string(body)))
+	for _, mw := range c.Middlewares {
+		if mw.BeforeRequest == nil {
+			continue
 		}
 
-		errMsg := sb.String()
-		logger.Error(errMsg)
-
-		return nil, "", errors.New(errMsg)
+		err = mw.BeforeRequest(req)
+		if err != nil {
+			return req, nil, err
+		}
 	}
 
-	logger.Infof("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
-	if err != nil {
-		logger.Errorf("failed to read response body: '%s'", err.Error())
+	logger := c.logger().With("module", r.Module, "method", req.Method, "url", req.URL.String())
 
-		return nil, "", fmt.Errorf("failed to read response body: '%w'", err)
+	res, err := c.send(req, logger)
+	if err != nil {
+		return req, nil, fmt.Errorf("request failed, url: %s: '%w'", url, err)
 	}
 
-	var t T
+	logger.Debug("request completed", "status", res.StatusCode)
 
-	err = json.Unmarshal(body, &t)
-	if err != nil {
-		logger.Errorf("failed to parse response body: '%s'", err.Error())
+	for _, mw := range c.Middlewares {
+		if mw.AfterResponse == nil {
+			continue
+		}
 
-		return nil, "", fmt.Errorf("failed to parse response body: '%w'", err)
-	}
+		err = mw.AfterResponse(req, res)
+		if err != nil {
+			res.Body.Close()
 
-	eTag := res.Header.Get("ETag")
-	if eTag == "" {
-		return nil, "", errors.New("missing or empty ETag header")
+			return req, nil, err
+		}
 	}
 
-	return &t, eTag, nil
+	return req, res, nil
 }
 
-func Put[P any, R any](c *Client, payload *P, eTag, endpoint, accept, module string) (*R, error) {
-	url := c.ServerURL.String() + endpoint
-
-	l := logging.NewLogger()
-	if c.Debug {
-		l.SetDebug()
+// Do sends the request and reads the whole response, non-2xx responses are returned as *APIError.
+func Do(ctx context.Context, c *Client, r *Request) (*Response, error) {
+	res, req, err := negotiate(ctx, c, r)
+	if err != nil {
+		return nil, c.fail(r, req, err)
 	}
 
-	logger := l.WithFields(logrus.Fields{
-		"METHOD": http.MethodPut,
-		"URL":    url,
-	})
-
-	var buf bytes.Buffer
+	return res, nil
+}
 
-	enc := json.NewEncoder(&buf)
+// Stream sends the request without reading the response, for bodies that are not JSON or
+// too large to buffer. The caller must close the body of the returned response, non-2xx
+// responses are returned as *APIError. The Accept header is sent as is, without negotiation.
+func Stream(ctx context.Context, c *Client, r *Request) (*http.Response, error) {
+	return StreamExpecting(ctx, c, r)
+}
 
-	err := enc.Encode(*payload)
+// StreamExpecting is Stream for callers handling some non-2xx statuses themselves, e.g. a
+// log that does not exist yet. Responses with an expected status are returned as is, they
+// are neither logged as errors nor reported to the OnError hooks of middlewares.
+func StreamExpecting(ctx context.Context, c *Client, r *Request, expected ...int) (*http.Response, error) {
+	req, res, err := c.roundTrip(ctx, r)
 	if err != nil {
-		logger.Errorf("failed to encode payload: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to encode payload: '%w'", err)
+		return nil, c.fail(r, req, err)
 	}
 
-	req, err := http.NewRequestWithContext(c.ctx, http.MethodPut, url, &buf)
-	if err != nil {
-		logger.Errorf("failed to create request object: '%s'", err.Error())
+	if (res.StatusCode < 200 || res.StatusCode >= 300) && !slices.Contains(expected, res.StatusCode) {
+		defer res.Body.Close()
 
-		return nil, fmt.Errorf("failed to create request object: '%w'", err)
-	}
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			body = nil
+		}
 
-	req.Header.Add("Accept", accept)
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("If-Match", eTag)
+		return nil, c.fail(r, req, newAPIError(res, body))
+	}
 
-	setAuth(c, req)
+	return res, nil
+}
 
-	res, err := c.HttpClient.Do(req)
+func do(ctx context.Context, c *Client, r *Request) (*Response, *http.Request, error) {
+	req, res, err := c.roundTrip(ctx, r)
 	if err != nil {
-		logger.Errorf("%s", err.Error())
-		return nil, fmt.Errorf("request failed: '%w'", err)
+		return nil, req, err
 	}
 
 	defer res.Body.Close()
@@ -257,178 +280,164 @@ func Put[P any, R any](c *Client, payload *P, eTag, endpoint, accept, module str
 	body, err := io.ReadAll(res.Body)
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		var sb strings.Builder
-
-		sb.WriteString(fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)))
-
-		if err == nil {
-			sb.WriteString(fmt.Sprintf(": '%s'", string(body)))
+		if err != nil {
+			body = nil
 		}
 
-		errMsg := sb.String()
-
-		logger.Error(errMsg)
-
-		return nil, errors.New(errMsg)
+		return nil, req, newAPIError(res, body)
 	}
 
-	logger.Infof("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
 	if err != nil {
-		logger.Errorf("failed to read response body: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to read response body: '%w'", err)
+		return nil, req, fmt.Errorf("failed to read response body: '%w'", err)
 	}
 
-	var r R
+	return &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+		Request:    req,
+	}, req, nil
+}
+
+func decode[T any](c *Client, r *Request, res *Response) (*T, error) {
+	var t T
 
-	err = json.Unmarshal(body, &r)
+	err := json.Unmarshal(res.Body, &t)
 	if err != nil {
-		logger.Errorf("failed to parse response body: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to parse response body: '%w'", err)
+		return nil, c.fail(r, res.Request, fmt.Errorf("failed to parse response body: '%w'", err))
 	}
 
-	return &r, nil
+	return &t, nil
 }
 
-func Post[P any, R any](c *Client, payload *P, endpoint string, accept string, module string) (*R, error) {
-	url := c.ServerURL.String() + endpoint
-
-	l := logging.NewLogger()
-	if c.Debug {
-		l.SetDebug()
+func encode[P any](c *Client, r *Request, payload *P) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return c.fail(r, nil, fmt.Errorf("failed to encode payload: '%w'", err))
 	}
 
-	logger := l.WithFields(logrus.Fields{
-		"METHOD": http.MethodPost,
-		"URL":    url,
-	})
+	r.Body = body
 
-	var buf bytes.Buffer
-
-	enc := json.NewEncoder(&buf)
+	return nil
+}
 
-	err := enc.Encode(*payload)
-	if err != nil {
-		logger.Errorf("failed to encode payload: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to encode payload: '%w'", err)
-	}
+func Get[T any](ctx context.Context, c *Client, endpoint, accept, module string) (*T, error) {
+	r := &Request{Method: http.MethodGet, Endpoint: endpoint, Accept: accept, Module: module}
 
-	req, err := http.NewRequestWithContext(c.ctx, http.MethodPost, url, &buf)
+	res, err := Do(ctx, c, r)
 	if err != nil {
-		logger.Errorf("failed to create request object: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to create request object: '%w'", err)
+		return nil, err
 	}
 
-	req.Header.Add("Accept", accept)
-	req.Header.Add("Content-Type", "application/json")
+	return decode[T](c, r, res)
+}
 
-	setAuth(c, req)
+func GetWithETag[T any](ctx context.Context, c *Client, endpoint, accept, module string) (*T, string, error) {
+	r := &Request{Method: http.MethodGet, Endpoint: endpoint, Accept: accept, Module: module}
 
-	res, err := c.HttpClient.Do(req)
+	res, err := Do(ctx, c, r)
 	if err != nil {
-		logger.Errorf("%s", err.Error())
-		return nil, fmt.Errorf("request failed: '%w'", err)
+		return nil, "", err
 	}
 
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		var sb strings.Builder
-
-		sb.WriteString(fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)))
-
-		if err == nil {
-			sb.WriteString(fmt.Sprintf(": '%s'", string(body)))
-		}
+	t, err := decode[T](c, r, res)
+	if err != nil {
+		return nil, "", err
+	}
 
-		errMsg := sb.String()
+	eTag := res.Header.Get("ETag")
+	if eTag == "" {
+		return nil, "", c.fail(r, res.Request, errors.New("missing or empty ETag header"))
+	}
 
-		logger.Error(errMsg)
+	return t, eTag, nil
+}
 
-		return nil, errors.New(errMsg)
+func Put[P any, R any](ctx context.Context, c *Client, payload *P, eTag, endpoint, accept, module string) (*R, error) {
+	r := &Request{
+		Method:   http.MethodPut,
+		Endpoint: endpoint,
+		Accept:   accept,
+		Module:   module,
+		Header:   http.Header{"If-Match": []string{eTag}},
 	}
 
-	logger.Infof("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	err := encode(c, r, payload)
 	if err != nil {
-		logger.Errorf("failed to read response body: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to read response body: '%w'", err)
+		return nil, err
 	}
 
-	var r R
-
-	err = json.Unmarshal(body, &r)
+	res, err := Do(ctx, c, r)
 	if err != nil {
-		logger.Errorf("failed to parse response body: '%s'", err.Error())
-		return nil, fmt.Errorf("failed to parse response body: '%w'", err)
+		return nil, err
 	}
 
-	return &r, nil
+	return decode[R](c, r, res)
 }
 
-func Delete(c *Client, endpoint, accept, module string) (string, error) {
-	url := c.ServerURL.String() + endpoint
-
-	l := logging.NewLogger()
-	if c.Debug {
-		l.SetDebug()
-	}
-	logger := l.WithFields(logrus.Fields{
-		"METHOD": http.MethodDelete,
-		"URL":    url,
-	})
+func Post[P any, R any](ctx context.Context, c *Client, payload *P, endpoint string, accept string, module string) (*R, error) {
+	r := &Request{Method: http.MethodPost, Endpoint: endpoint, Accept: accept, Module: module}
 
-	req, err := http.NewRequestWithContext(c.ctx, http.MethodDelete, url, nil)
+	err := encode(c, r, payload)
 	if err != nil {
-		logger.Errorf("failed to create request object: '%s'", err.Error())
-		return "", fmt.Errorf("failed to create request object: '%w'", err)
+		return nil, err
 	}
 
-	req.Header.Add("Accept", accept)
-
-	setAuth(c, req)
-
-	res, err := c.HttpClient.Do(req)
+	res, err := Do(ctx, c, r)
 	if err != nil {
-		logger.Errorf("%s", err.Error())
-		return "", fmt.Errorf("request failed: '%w'", err)
+		return nil, err
 	}
 
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		var sb strings.Builder
-
-		sb.WriteString(fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)))
+	return decode[R](c, r, res)
+}
 
-		if err == nil {
-			sb.WriteString(fmt.Sprintf(": '%s'", string(body)))
+// PostConfirm sends a POST with the X-GoCD-Confirm header GoCD requires for operations
+// such as pausing or scheduling a pipeline, a nil payload sends no body at all.
+func PostConfirm[P any, R any](ctx context.Context, c *Client, payload *P, endpoint, accept, module string) (*R, error) {
+	r := &Request{
+		Method:   http.MethodPost,
+		Endpoint: endpoint,
+		Accept:   accept,
+		Module:   module,
+		Header:   http.Header{"X-Gocd-Confirm": []string{"true"}},
+	}
+
+	if payload != nil {
+		err := encode(c, r, payload)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		errMsg := sb.String()
+	res, err := Do(ctx, c, r)
+	if err != nil {
+		return nil, err
+	}
 
-		logger.Error(errMsg)
+	return decode[R](c, r, res)
+}
 
-		return "", errors.New(errMsg)
-	}
+// Message is the body GoCD answers with for deletions and operations.
+type Message struct {
+	Message string `json:"message"`
+}
 
-	logger.Infof("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+// MessageText unwraps the result of an operation answering with a Message.
+func MessageText(res *Message, err error) (string, error) {
 	if err != nil {
-		logger.Errorf("failed to read response body: '%s'", err.Error())
-		return "", fmt.Errorf("failed to read response body: '%w'", err)
+		return "", err
 	}
 
-	var resMsg struct {
-		Message string `json:"message"`
-	}
+	return res.Message, nil
+}
+
+func Delete(ctx context.Context, c *Client, endpoint, accept, module string) (string, error) {
+	r := &Request{Method: http.MethodDelete, Endpoint: endpoint, Accept: accept, Module: module}
 
-	err = json.Unmarshal(body, &resMsg)
+	res, err := Do(ctx, c, r)
 	if err != nil {
-		logger.Errorf("failed to parse response body: '%s'", err.Error())
-		return "", fmt.Errorf("failed to parse response body: '%w'", err)
+		return "", err
 	}
 
-	return resMsg.Message, nil
+	return MessageText(decode[Message](c, r, res))
 }
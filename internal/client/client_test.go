@@ -1,18 +1,25 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
 	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/logging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,11 +49,11 @@ func TestClient_SetAuth(t *testing.T) {
 	}{
 		{
 			name:   "NewClientShouldHaveAuthTypeNone",
-			client: NewClient(context.TODO(), &url.URL{}),
+			client: NewClient(&url.URL{}),
 		},
 		{
 			name:     "Should set basic auth",
-			client:   NewClient(context.TODO(), &url.URL{}),
+			client:   NewClient(&url.URL{}),
 			authType: Basic,
 			args: args{
 				user:     "user",
@@ -55,7 +62,7 @@ func TestClient_SetAuth(t *testing.T) {
 		},
 		{
 			name:     "Should set accessToken auth",
-			client:   NewClient(context.TODO(), &url.URL{}),
+			client:   NewClient(&url.URL{}),
 			authType: AccessToken,
 			args: args{
 				token: "token",
@@ -110,7 +117,6 @@ func TestNewClient(t *testing.T) {
 				HttpClient: &http.Client{
 					Timeout: time.Minute,
 				},
-				ctx: context.TODO(),
 			},
 		},
 	}
@@ -118,7 +124,7 @@ func TestNewClient(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := NewClient(context.TODO(), tt.args.server)
+			got := NewClient(tt.args.server)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -156,14 +162,14 @@ func Test_setAuth(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			client := NewClient(context.TODO(), &url.URL{})
+			client := NewClient(&url.URL{})
 			switch tt.args.auth {
 			case Basic:
 				client.SetBasicAuth(tt.args.user, tt.args.password)
 			case AccessToken:
 				client.SetAccessToken(tt.args.token)
 			}
-			req, err := http.NewRequestWithContext(client.ctx, http.MethodGet, "https://fake.com", nil)
+			req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://fake.com", nil)
 			require.NoError(t, err)
 
 			setAuth(client, req)
@@ -308,7 +314,7 @@ func TestGet(t *testing.T) {
 			t.Parallel()
 			defer tt.args.ts.Close()
 			url, _ := url.Parse(tt.args.ts.URL)
-			got, err := Get[Version](NewClient(context.TODO(), url), "/", constants.AcceptV1, "test")
+			got, err := Get[Version](context.TODO(), NewClient(url), "/", constants.AcceptV1, "test")
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -330,16 +336,16 @@ func TestRequestFailureWithTimeout(t *testing.T) {
 	defer ts.Close()
 
 	url, _ := url.Parse(ts.URL)
-	c := NewClient(context.TODO(), url)
+	c := NewClient(url)
 	c.HttpClient.Timeout = 1 * time.Second // Set the client timeout to 1 second
 
 	// Test the Get function
-	_, err := Get[Version](c, "/", constants.AcceptV1, "test")
+	_, err := Get[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "Client.Timeout")
 
 	// Test the GetETag function
-	_, _, err = GetWithETag[Version](c, "/", constants.AcceptV1, "test")
+	_, _, err = GetWithETag[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "Client.Timeout")
 }
@@ -353,15 +359,15 @@ func TestNon2xxSuccess(t *testing.T) {
 	defer ts.Close()
 
 	url, _ := url.Parse(ts.URL)
-	c := NewClient(context.TODO(), url)
+	c := NewClient(url)
 
 	// Test the Get function
-	_, err := Get[Version](c, "/", constants.AcceptV1, "test")
+	_, err := Get[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "301 Moved Permanently")
 
 	// Test the GetETag function
-	_, etag, err := GetWithETag[Version](c, "/", constants.AcceptV1, "test")
+	_, etag, err := GetWithETag[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
 	assert.Empty(t, etag)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "301 Moved Permanently")
@@ -369,7 +375,7 @@ func TestNon2xxSuccess(t *testing.T) {
 
 func TestClientErrorMalformedURL(t *testing.T) {
 	t.Parallel()
-	_, err := Get[Version](NewClient(context.TODO(), &url.URL{}), "/", constants.AcceptV1, "test")
+	_, err := Get[Version](context.TODO(), NewClient(&url.URL{}), "/", constants.AcceptV1, "test")
 	require.Error(t, err)
 	// The error message should indicate the URL is invalid
 	assert.Contains(t, err.Error(), "unsupported protocol scheme")
@@ -488,10 +494,10 @@ func TestGetETag(t *testing.T) {
 			defer ts.Close()
 
 			url, _ := url.Parse(ts.URL)
-			c := NewClient(context.TODO(), url)
+			c := NewClient(url)
 			c.HttpClient.Transport = tt.transport
 
-			_, gotETag, err := GetWithETag[Version](c, "/", constants.AcceptV1, "test")
+			_, gotETag, err := GetWithETag[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
 
 			if tt.wantErr {
 				require.Error(t, err, "Expected an error but got none")
@@ -649,10 +655,10 @@ func TestPut(t *testing.T) {
 			server := httptest.NewServer(tt.setupHandler(t))
 			defer server.Close()
 
-			client := NewClient(context.Background(), &url.URL{Scheme: "http", Host: server.Listener.Addr().String()})
+			client := NewClient(&url.URL{Scheme: "http", Host: server.Listener.Addr().String()})
 			client.HttpClient.Transport = tt.transport
 
-			got, err := Put[Payload, Payload](client, tt.payload, tt.eTag, tt.endpoint, constants.AcceptV1, "test-module")
+			got, err := Put[Payload, Payload](tt.ctx, client, tt.payload, tt.eTag, tt.endpoint, constants.AcceptV1, "test-module")
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -799,10 +805,10 @@ func TestPost(t *testing.T) {
 			server := httptest.NewServer(tt.setupHandler(t))
 			defer server.Close()
 
-			client := NewClient(context.Background(), &url.URL{Scheme: "http", Host: server.Listener.Addr().String()})
+			client := NewClient(&url.URL{Scheme: "http", Host: server.Listener.Addr().String()})
 			client.HttpClient.Transport = tt.transport
 
-			got, err := Post[Payload, Response](client, tt.payload, tt.endpoint, "application/json", "test-module")
+			got, err := Post[Payload, Response](context.TODO(), client, tt.payload, tt.endpoint, "application/json", "test-module")
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -908,10 +914,10 @@ func TestDelete(t *testing.T) {
 			server := httptest.NewServer(tt.setupHandler(t))
 			defer server.Close()
 
-			client := NewClient(context.Background(), &url.URL{Scheme: "http", Host: server.Listener.Addr().String()})
+			client := NewClient(&url.URL{Scheme: "http", Host: server.Listener.Addr().String()})
 			client.HttpClient.Transport = tt.transport
 
-			got, err := Delete(client, tt.endpoint, "application/json", "test-module")
+			got, err := Delete(context.TODO(), client, tt.endpoint, "application/json", "test-module")
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -921,3 +927,588 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantMessage string
+		wantData    map[string]any
+		is          func(error) bool
+	}{
+		{
+			name:        "Not found",
+			status:      http.StatusNotFound,
+			body:        `{"message": "Either the resource you requested was not found, or you are not authorized to perform this action."}`,
+			wantMessage: "Either the resource you requested was not found, or you are not authorized to perform this action.",
+			is:          IsNotFound,
+		},
+		{
+			name:   "Precondition failed",
+			status: http.StatusPreconditionFailed,
+			is:     IsPreconditionFailed,
+		},
+		{
+			name:        "Validation error with data",
+			status:      http.StatusUnprocessableEntity,
+			body:        `{"message": "Validation error.", "data": {"id": "pkg-1", "errors": {"name": ["is required"]}}}`,
+			wantMessage: "Validation error.",
+			wantData: map[string]any{
+				"id":     "pkg-1",
+				"errors": map[string]any{"name": []any{"is required"}},
+			},
+			is: IsUnprocessable,
+		},
+		{
+			name:   "Unauthorized",
+			status: http.StatusUnauthorized,
+			body:   `not json`,
+			is:     IsUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Test", "yes")
+				w.WriteHeader(tt.status)
+				_, err := w.Write([]byte(tt.body))
+				if err != nil {
+					t.Errorf("failed to write body: '%s'", err.Error())
+				}
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			_, err := Get[Version](context.TODO(), NewClient(url), "/endpoint", constants.AcceptV1, "test")
+			require.Error(t, err)
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, http.MethodGet, apiErr.Method)
+			assert.Equal(t, ts.URL+"/endpoint", apiErr.URL)
+			assert.Equal(t, "yes", apiErr.Header.Get("X-Test"))
+			assert.Equal(t, tt.wantMessage, apiErr.Message)
+			assert.Equal(t, tt.wantData, apiErr.Data)
+			assert.True(t, tt.is(err))
+			assert.False(t, IsConflict(err))
+		})
+	}
+}
+
+func TestRequestCanceledByContext(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	c := NewClient(url)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := Get[Version](ctx, c, "/", constants.AcceptV1, "test")
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	type Payload struct {
+		Message string `json:"message"`
+	}
+
+	policy := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		RetryStatuses:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+	}
+
+	tests := []struct {
+		name         string
+		call         func(c *Client) error
+		failures     int
+		retryAfter   string
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name: "GET succeeds after transient failures",
+			call: func(c *Client) error {
+				_, err := Get[Payload](context.TODO(), c, "/", constants.AcceptV1, "test")
+
+				return err
+			},
+			failures:     2,
+			wantAttempts: 3,
+		},
+		{
+			name: "GET gives up after max attempts",
+			call: func(c *Client) error {
+				_, err := Get[Payload](context.TODO(), c, "/", constants.AcceptV1, "test")
+
+				return err
+			},
+			failures:     5,
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name: "GET honors Retry-After",
+			call: func(c *Client) error {
+				_, err := Get[Payload](context.TODO(), c, "/", constants.AcceptV1, "test")
+
+				return err
+			},
+			failures:     1,
+			retryAfter:   "0",
+			wantAttempts: 2,
+		},
+		{
+			name: "PUT with If-Match is never replayed",
+			call: func(c *Client) error {
+				_, err := Put[Payload, Payload](context.TODO(), c, &Payload{}, "etag", "/", constants.AcceptV1, "test")
+
+				return err
+			},
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "POST is not retried by default",
+			call: func(c *Client) error {
+				_, err := Post[Payload, Payload](context.TODO(), c, &Payload{}, "/", constants.AcceptV1, "test")
+
+				return err
+			},
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "Streamed body is never replayed",
+			call: func(c *Client) error {
+				// a MultiReader has no GetBody, unlike a strings.Reader
+				body := io.MultiReader(bytes.NewReader([]byte("data")))
+				_, err := Do(context.TODO(), c, &Request{Method: http.MethodPut, Endpoint: "/", Module: "test", Reader: body})
+
+				return err
+			},
+			failures:     1,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var attempts atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if int(attempts.Add(1)) <= tt.failures {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+				_, err := w.Write([]byte(`{"message": "ok"}`))
+				if err != nil {
+					t.Errorf("failed to write body: '%s'", err.Error())
+				}
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			c := NewClient(url)
+			c.Retry = &policy
+
+			err := tt.call(c)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+	policy := DefaultRetryPolicy()
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, policy.backoff(1, res))
+
+	for attempt := 1; attempt < 10; attempt++ {
+		wait := policy.backoff(attempt, nil)
+		assert.LessOrEqual(t, wait, policy.MaxBackoff)
+		assert.GreaterOrEqual(t, wait, min(policy.InitialBackoff<<(attempt-1), policy.MaxBackoff)/2)
+	}
+
+	refused := &url.Error{Op: "Put", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPut, "http://fake.com", nil)
+	require.NoError(t, err)
+	req.Header.Set("If-Match", "etag")
+	assert.True(t, policy.shouldRetry(req, nil, refused))
+	assert.False(t, policy.shouldRetry(req, nil, errors.New("connection reset by peer")))
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	url, _ := url.Parse(ts.URL)
+	c := NewClient(url)
+	c.Logger = logging.NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	_, err := Get[Version](context.TODO(), c, "/api/version", constants.AcceptV1, "version")
+	require.Error(t, err)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "version", record["module"])
+	assert.Equal(t, http.MethodGet, record["method"])
+	assert.Equal(t, ts.URL+"/api/version", record["url"])
+	assert.InDelta(t, http.StatusNotFound, record["status"], 0)
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Request-Id") != "42" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+		w.Header().Set("X-Audit", "seen")
+		_, err := w.Write([]byte(`{"version": "23.1.0"}`))
+		if err != nil {
+			t.Errorf("failed to write body: '%s'", err.Error())
+		}
+	}))
+	defer ts.Close()
+
+	var calls []string
+	var failures []error
+	url, _ := url.Parse(ts.URL)
+	c := NewClient(url)
+	c.Middlewares = []Middleware{
+		{
+			BeforeRequest: func(req *http.Request) error {
+				calls = append(calls, "before:"+req.Method)
+				req.Header.Set("X-Request-Id", "42")
+
+				return nil
+			},
+			AfterResponse: func(req *http.Request, res *http.Response) error {
+				calls = append(calls, "after:"+res.Header.Get("X-Audit"))
+
+				return nil
+			},
+		},
+		{
+			OnError: func(req *http.Request, err error) {
+				failures = append(failures, err)
+			},
+		},
+	}
+
+	got, err := Get[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
+	require.NoError(t, err)
+	assert.Equal(t, "23.1.0", got.Version)
+	assert.Equal(t, []string{"before:GET", "after:seen"}, calls)
+	assert.Empty(t, failures)
+
+	abort := errors.New("aborted by middleware")
+	c.Middlewares = append(c.Middlewares, Middleware{
+		BeforeRequest: func(req *http.Request) error {
+			return abort
+		},
+	})
+
+	_, err = Get[Version](context.TODO(), c, "/", constants.AcceptV1, "test")
+	require.ErrorIs(t, err, abort)
+	require.Len(t, failures, 1)
+	assert.ErrorIs(t, failures[0], abort)
+}
+
+func TestModify(t *testing.T) {
+	t.Parallel()
+
+	type Entity struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	tests := []struct {
+		name      string
+		conflicts int
+		wantCount int
+		wantPuts  int32
+		wantErr   bool
+	}{
+		{
+			name:      "No conflict",
+			wantCount: 11,
+			wantPuts:  1,
+		},
+		{
+			name:      "Re-applies the mutation after a conflict",
+			conflicts: 2,
+			wantCount: 13,
+			wantPuts:  3,
+		},
+		{
+			name:      "Gives up after max attempts",
+			conflicts: 10,
+			wantPuts:  3,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gets, puts atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					// somebody else bumps the count between every read
+					count := 10 + gets.Add(1) - 1
+					w.Header().Set("ETag", fmt.Sprintf(`"%d"`, count))
+					_ = json.NewEncoder(w).Encode(Entity{Name: "entity", Count: int(count)})
+				case http.MethodPut:
+					if int(puts.Add(1)) <= tt.conflicts {
+						w.WriteHeader(http.StatusPreconditionFailed)
+
+						return
+					}
+					var e Entity
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+					assert.Equal(t, fmt.Sprintf(`"%d"`, e.Count-1), r.Header.Get("If-Match"))
+					_ = json.NewEncoder(w).Encode(e)
+				}
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			got, err := Modify(context.TODO(), NewClient(url), "/entity", constants.AcceptV1, "test", func(e *Entity) error {
+				e.Count++
+
+				return nil
+			})
+			assert.Equal(t, tt.wantPuts, puts.Load())
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, IsPreconditionFailed(err))
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCount, got.Count)
+		})
+	}
+}
+
+func TestNegotiation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		negotiate     bool
+		serverVersion string
+		supported     string
+		calls         int
+		wantAccepts   []string
+	}{
+		{
+			name:        "Falls back on 406 without negotiation",
+			supported:   constants.AcceptV1,
+			calls:       1,
+			wantAccepts: []string{constants.AcceptV2, constants.AcceptV1},
+		},
+		{
+			name:      "Detects the server version once",
+			negotiate: true,
+			supported: constants.AcceptV1,
+			calls:     2,
+			// /api/version first, then the packages calls with the version 18.1.0 serves
+			wantAccepts: []string{constants.AcceptV1, constants.AcceptV1, constants.AcceptV1},
+		},
+		{
+			name:          "Uses the configured server version",
+			negotiate:     true,
+			serverVersion: "23.1.0",
+			supported:     constants.AcceptV2,
+			calls:         1,
+			wantAccepts:   []string{constants.AcceptV2},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var accepts []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				accepts = append(accepts, r.Header.Get("Accept"))
+				if r.URL.Path == "/api/version" {
+					_, _ = w.Write([]byte(`{"version": "18.1.0"}`))
+
+					return
+				}
+				if r.Header.Get("Accept") != tt.supported {
+					w.WriteHeader(http.StatusNotAcceptable)
+
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": "pkg"}`))
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			c := NewClient(url)
+			c.Negotiate = tt.negotiate
+			c.ServerVersion = tt.serverVersion
+
+			for i := 0; i < tt.calls; i++ {
+				_, err := Get[map[string]any](context.TODO(), c, "/api/admin/packages/pkg", constants.AcceptV2, "packages")
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAccepts, accepts)
+		})
+	}
+}
+
+func TestStreamExpecting(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	c := NewClient(url)
+
+	var failures int
+	c.Middlewares = []Middleware{{OnError: func(*http.Request, error) { failures++ }}}
+
+	res, err := StreamExpecting(context.TODO(), c, &Request{Method: http.MethodGet, Endpoint: "/log", Module: "test"}, http.StatusNotFound)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.Zero(t, failures)
+
+	_, err = Stream(context.TODO(), c, &Request{Method: http.MethodGet, Endpoint: "/log", Module: "test"})
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, 1, failures)
+}
+
+func TestNegotiationNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		status      map[string]int
+		wantAccepts []string
+		wantErr     error
+	}{
+		{
+			name:        "Falls back on 404 from an older server",
+			status:      map[string]int{constants.AcceptV2: http.StatusNotFound},
+			wantAccepts: []string{constants.AcceptV2, constants.AcceptV1},
+		},
+		{
+			name:        "Keeps the 404 of a missing resource",
+			status:      map[string]int{constants.AcceptV2: http.StatusNotFound, constants.AcceptV1: http.StatusNotAcceptable},
+			wantAccepts: []string{constants.AcceptV2, constants.AcceptV1},
+			wantErr:     ErrNotFound,
+		},
+		{
+			name:        "Gives up after the oldest version",
+			status:      map[string]int{constants.AcceptV2: http.StatusNotFound, constants.AcceptV1: http.StatusNotFound},
+			wantAccepts: []string{constants.AcceptV2, constants.AcceptV1},
+			wantErr:     ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var accepts []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				accepts = append(accepts, r.Header.Get("Accept"))
+				if status := tt.status[r.Header.Get("Accept")]; status != 0 {
+					w.WriteHeader(status)
+
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": "pkg"}`))
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			_, err := Get[map[string]any](context.TODO(), NewClient(url), "/api/admin/packages/pkg", constants.AcceptV2, "packages")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAccepts, accepts)
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "not found"}`))
+
+			return
+		}
+		assert.Equal(t, "text/plain", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte("raw output\n"))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	c := NewClient(url)
+
+	res, err := Stream(context.TODO(), c, &Request{Method: http.MethodGet, Endpoint: "/log", Accept: "text/plain", Module: "test"})
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "raw output\n", string(body))
+
+	_, err = Stream(context.TODO(), c, &Request{Method: http.MethodGet, Endpoint: "/missing", Module: "test"})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "not found", apiErr.Message)
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrBadRequest         = errors.New("bad request")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrForbidden          = errors.New("forbidden")
+	ErrNotFound           = errors.New("not found")
+	ErrNotAcceptable      = errors.New("not acceptable")
+	ErrConflict           = errors.New("conflict")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrUnprocessable      = errors.New("unprocessable entity")
+	ErrTooManyRequests    = errors.New("too many requests")
+	ErrServerError        = errors.New("server error")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:          ErrBadRequest,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusNotAcceptable:       ErrNotAcceptable,
+	http.StatusConflict:            ErrConflict,
+	http.StatusPreconditionFailed:  ErrPreconditionFailed,
+	http.StatusUnprocessableEntity: ErrUnprocessable,
+	http.StatusTooManyRequests:     ErrTooManyRequests,
+}
+
+// APIError is returned for every non-2xx response received from the GoCD server.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Header     http.Header
+	Body       []byte
+	// Message and Data are decoded from the GoCD error body, when present.
+	Message string
+	Data    map[string]any
+}
+
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+	}
+
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.URL = res.Request.URL.String()
+	}
+
+	var payload struct {
+		Message string         `json:"message"`
+		Data    map[string]any `json:"data"`
+	}
+
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.Message = payload.Message
+		apiErr.Data = payload.Data
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)))
+
+	if len(e.Body) > 0 {
+		sb.WriteString(fmt.Sprintf(": '%s'", string(e.Body)))
+	}
+
+	return sb.String()
+}
+
+// Is reports whether the error matches one of the status sentinels, e.g. ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	if target == ErrServerError {
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+
+	sentinel, ok := statusErrors[e.StatusCode]
+
+	return ok && sentinel == target
+}
+
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+func IsUnprocessable(err error) bool {
+	return errors.Is(err, ErrUnprocessable)
+}
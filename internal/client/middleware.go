@@ -0,0 +1,17 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Middleware hooks into every request made by the client, all hooks are optional.
+type Middleware struct {
+	// BeforeRequest runs once auth and default headers are set and may mutate the request,
+	// returning an error aborts it.
+	BeforeRequest func(req *http.Request) error
+	// AfterResponse runs before the status code is checked, it must not consume the body,
+	// returning an error fails the request.
+	AfterResponse func(req *http.Request, res *http.Response) error
+	// OnError is called with the error a request fails with, req is nil when it could not be built.
+	OnError func(req *http.Request, err error)
+}
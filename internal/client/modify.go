@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+)
+
+const defaultModifyAttempts = 3
+
+// Modify performs an optimistic read-modify-write of the entity at endpoint: it fetches it
+// with its ETag, applies mutate and PUTs it back with If-Match. When somebody else changed
+// the entity in the meantime (412) the whole cycle is repeated, up to c.ModifyAttempts times.
+func Modify[T any](ctx context.Context, c *Client, endpoint, accept, module string, mutate func(*T) error) (*T, error) {
+	attempts := c.ModifyAttempts
+	if attempts <= 0 {
+		attempts = defaultModifyAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		current, eTag, err := GetWithETag[T](ctx, c, endpoint, accept, module)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mutate(current)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := Put[T, T](ctx, c, current, eTag, endpoint, accept, module)
+		if err == nil || !IsPreconditionFailed(err) || attempt >= attempts {
+			return updated, err
+		}
+
+		c.logger().Debug("entity changed concurrently, retrying update", "module", module, "endpoint", endpoint, "attempt", attempt)
+	}
+}
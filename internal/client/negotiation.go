@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/apiversions"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+)
+
+const versionEndpoint = "/api/version"
+
+// release returns the GoCD release of the server, fetching /api/version the first time.
+// A failed lookup is not cached, the next request tries again.
+func (c *Client) release(ctx context.Context) *apiversions.Release {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	if c.serverRelease != nil {
+		return c.serverRelease
+	}
+
+	version := c.ServerVersion
+	if version == "" {
+		payload, err := Get[struct {
+			Version string `json:"version"`
+		}](ctx, c, versionEndpoint, constants.AcceptV1, "version")
+		if err != nil {
+			c.logger().Warn("failed to detect the server version, using default API versions", "error", err)
+
+			return nil
+		}
+
+		version = payload.Version
+	}
+
+	release, ok := apiversions.ParseRelease(version)
+	if !ok {
+		c.logger().Warn("unrecognized server version, using default API versions", "version", version)
+
+		return nil
+	}
+
+	c.serverRelease = &release
+
+	return c.serverRelease
+}
+
+// acceptCandidates returns the Accept headers to try for r, best first.
+func (c *Client) acceptCandidates(ctx context.Context, r *Request) []string {
+	endpoint, ok := apiversions.Lookup(r.Endpoint)
+	if !ok || r.Endpoint == versionEndpoint {
+		return []string{r.Accept}
+	}
+
+	var release *apiversions.Release
+	if c.Negotiate {
+		release = c.release(ctx)
+	}
+
+	return endpoint.Candidates(r.Accept, release)
+}
+
+// negotiate sends r with each candidate Accept header until one is served. A 406 Not
+// Acceptable moves on to the next candidate, and so does a 404 Not Found while an older
+// candidate remains, servers predating a version answer it with 404. A 404 no older
+// version resolved is returned as is, the resource may simply not exist.
+func negotiate(ctx context.Context, c *Client, r *Request) (*Response, *http.Request, error) {
+	candidates := c.acceptCandidates(ctx, r)
+
+	var (
+		notFound    error
+		notFoundReq *http.Request
+	)
+
+	for i, accept := range candidates {
+		attempt := *r
+		attempt.Accept = accept
+
+		res, req, err := do(ctx, c, &attempt)
+		last := i == len(candidates)-1
+
+		switch {
+		case err == nil:
+			return res, req, nil
+		case !last && errors.Is(err, ErrNotAcceptable):
+			c.logger().Debug("API version not acceptable, falling back", "module", r.Module, "endpoint", r.Endpoint, "accept", accept)
+		case !last && errors.Is(err, ErrNotFound) && apiversions.Older(candidates[i+1], accept):
+			if notFound == nil {
+				notFound, notFoundReq = err, req
+			}
+
+			c.logger().Debug("API version not found, falling back", "module", r.Module, "endpoint", r.Endpoint, "accept", accept)
+		default:
+			// older versions rejecting the request do not hide that the resource is missing
+			if notFound != nil && (errors.Is(err, ErrNotAcceptable) || errors.Is(err, ErrNotFound)) {
+				return nil, notFoundReq, notFound
+			}
+
+			return res, req, err
+		}
+	}
+
+	return nil, nil, errors.New("no API version to negotiate")
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MinPageSize and MaxPageSize bound the page sizes GoCD accepts for pipeline, stage and
+// job history.
+const (
+	MinPageSize = 10
+	MaxPageSize = 100
+)
+
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// PageEndpoint adds the cursor pagination parameters to path, zero values are left out.
+// A page size GoCD would reject is reported before any request is sent.
+func PageEndpoint(path string, pageSize int, after, before string) (string, error) {
+	if pageSize != 0 && (pageSize < MinPageSize || pageSize > MaxPageSize) {
+		return "", fmt.Errorf("%w: %d, GoCD accepts %d to %d", ErrInvalidPageSize, pageSize, MinPageSize, MaxPageSize)
+	}
+
+	query := url.Values{}
+
+	if pageSize > 0 {
+		query.Set("page_size", strconv.Itoa(pageSize))
+	}
+
+	if after != "" {
+		query.Set("after", after)
+	}
+
+	if before != "" {
+		query.Set("before", before)
+	}
+
+	if len(query) == 0 {
+		return path, nil
+	}
+
+	return path + "?" + query.Encode(), nil
+}
+
+// PageFetcher loads the page at endpoint and returns its items along with the href of
+// the next page, empty on the last one.
+type PageFetcher[T any] func(ctx context.Context, endpoint string) ([]T, string, error)
+
+// GetPage returns a PageFetcher decoding each page as P, split picks its items and the
+// href of the next page out of it.
+func GetPage[P any, T any](c *Client, accept, module string, split func(*P) ([]T, string)) PageFetcher[T] {
+	return func(ctx context.Context, endpoint string) ([]T, string, error) {
+		page, err := Get[P](ctx, c, endpoint, accept, module)
+		if err != nil {
+			return nil, "", err
+		}
+
+		items, next := split(page)
+
+		return items, next, nil
+	}
+}
+
+// Pager walks a cursor paginated collection, fetching pages only when the buffered
+// items run out.
+type Pager[T any] struct {
+	fetch    PageFetcher[T]
+	endpoint string
+	done     bool
+	limit    int
+	seen     int
+	items    []T
+	value    T
+	err      error
+}
+
+// NewPager starts at endpoint and stops after limit items, 0 walks the whole collection.
+func NewPager[T any](endpoint string, limit int, fetch PageFetcher[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch, endpoint: endpoint, limit: limit}
+}
+
+// NewStaticPager yields items and then stops with err, without fetching anything.
+func NewStaticPager[T any](items []T, err error) *Pager[T] {
+	return &Pager[T]{items: items, done: true, err: err}
+}
+
+// Next advances to the next item, it returns false once the collection, or the limit, is
+// exhausted or a page could not be fetched.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.limit > 0 && p.seen >= p.limit {
+		return false
+	}
+
+	for len(p.items) == 0 {
+		if p.done || p.err != nil {
+			return false
+		}
+
+		items, next, err := p.fetch(ctx, p.endpoint)
+		if err != nil {
+			p.err = err
+
+			return false
+		}
+
+		p.items = items
+
+		endpoint, err := nextEndpoint(p.endpoint, next)
+		if err != nil {
+			p.err = err
+
+			return false
+		}
+
+		// a server echoing the same cursor would otherwise keep us here forever
+		p.done = endpoint == "" || endpoint == p.endpoint
+		p.endpoint = endpoint
+	}
+
+	p.value = p.items[0]
+	p.items = p.items[1:]
+	p.seen++
+
+	return true
+}
+
+// Value returns the item Next advanced to.
+func (p *Pager[T]) Value() T {
+	return p.value
+}
+
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// nextEndpoint applies the cursor of the HAL next link to the current endpoint. Only the
+// query is taken from href, GoCD builds it from its own idea of the server URL which
+// may not be the one the client talks to, e.g. behind a proxy.
+func nextEndpoint(current, href string) (string, error) {
+	if href == "" {
+		return "", nil
+	}
+
+	next, err := url.Parse(href)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link '%s': '%w'", href, err)
+	}
+
+	path, rawQuery, _ := strings.Cut(current, "?")
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("invalid query '%s': '%w'", rawQuery, err)
+	}
+
+	// after and before are mutually exclusive, the link decides the direction
+	query.Del("after")
+	query.Del("before")
+
+	for key, values := range next.Query() {
+		query[key] = values
+	}
+
+	return path + "?" + query.Encode(), nil
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/logging"
+)
+
+const (
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = 500 * time.Millisecond
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy controls how failed requests are retried, the zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// RetryStatuses lists the response codes that are worth retrying.
+	RetryStatuses []int
+	// RetryNonIdempotent also retries POST requests on a retryable status.
+	RetryNonIdempotent bool
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		RetryStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	case http.MethodPut:
+		// a conditional PUT that reached the server must not be replayed, the
+		// second attempt would either fail with 412 or overwrite someone else's change
+		return req.Header.Get("If-Match") == ""
+	default:
+		return false
+	}
+}
+
+// replayable reports whether the body of the request can be sent again, a streamed body
+// is consumed by the first attempt.
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// notSent reports whether the request failed before reaching the server.
+func notSent(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func (p *RetryPolicy) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return notSent(err) || isIdempotent(req)
+	}
+
+	retryable := false
+
+	for _, status := range p.RetryStatuses {
+		if res.StatusCode == status {
+			retryable = true
+
+			break
+		}
+	}
+
+	if !retryable {
+		return false
+	}
+
+	return isIdempotent(req) || (p.RetryNonIdempotent && req.Method == http.MethodPost)
+}
+
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if wait, ok := retryAfter(res); ok {
+		return wait
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	wait = min(wait, maxBackoff)
+	if wait <= 0 {
+		return 0
+	}
+
+	// equal jitter: half of the delay is fixed, the other half random
+	half := wait / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)+1)) //nolint:gosec // jitter does not need a CSPRNG
+}
+
+// Sleep waits for d, returning early with the error of ctx when it is done first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// send executes the request, retrying it according to the client's RetryPolicy.
+func (c *Client) send(req *http.Request, logger logging.Logger) (*http.Response, error) {
+	policy := c.Retry
+	if policy == nil || policy.MaxAttempts <= 1 {
+		return c.HttpClient.Do(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+
+		if attempt > 1 {
+			attemptReq = req.Clone(req.Context())
+
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+
+				attemptReq.Body = body
+			}
+		}
+
+		res, err := c.HttpClient.Do(attemptReq)
+		if attempt >= policy.MaxAttempts || !replayable(req) || !policy.shouldRetry(attemptReq, res, err) {
+			return res, err
+		}
+
+		wait := policy.backoff(attempt, res)
+
+		if res != nil {
+			logger.Warn("retrying request", "status", res.StatusCode, "wait", wait, "attempt", attempt, "max_attempts", policy.MaxAttempts)
+
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		} else {
+			logger.Warn("retrying request", "error", err, "wait", wait, "attempt", attempt, "max_attempts", policy.MaxAttempts)
+		}
+
+		if err := Sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
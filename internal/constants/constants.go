@@ -1,8 +1,15 @@
 package constants
 
 const (
-	AcceptV1 = "application/vnd.go.cd.v1+json"
-	AcceptV2 = "application/vnd.go.cd.v2+json"
-	AcceptV3 = "application/vnd.go.cd.v3+json"
-	AcceptV4 = "application/vnd.go.cd.v4+json"
+	AcceptV1  = "application/vnd.go.cd.v1+json"
+	AcceptV2  = "application/vnd.go.cd.v2+json"
+	AcceptV3  = "application/vnd.go.cd.v3+json"
+	AcceptV4  = "application/vnd.go.cd.v4+json"
+	AcceptV5  = "application/vnd.go.cd.v5+json"
+	AcceptV6  = "application/vnd.go.cd.v6+json"
+	AcceptV7  = "application/vnd.go.cd.v7+json"
+	AcceptV8  = "application/vnd.go.cd.v8+json"
+	AcceptV9  = "application/vnd.go.cd.v9+json"
+	AcceptV10 = "application/vnd.go.cd.v10+json"
+	AcceptV11 = "application/vnd.go.cd.v11+json"
 )
@@ -0,0 +1,17 @@
+package dashboard
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/dashboard"
+)
+
+func GetDashboard(ctx context.Context, c *client.Client) (*types.Dashboard, error) {
+	return client.Get[types.Dashboard](ctx, c, endpoint, constants.AcceptV4, "dashboard")
+}
@@ -0,0 +1,99 @@
+package dashboard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dashboard = `{
+	"_personalization": "3d1ec2b4",
+	"_embedded": {
+		"pipeline_groups": [
+			{"name": "team-a", "pipelines": ["api", "web"], "can_administer": true},
+			{"name": "team-b", "pipelines": ["batch"], "can_administer": false}
+		],
+		"environments": [
+			{"name": "prod", "pipelines": ["web"], "can_administer": false}
+		],
+		"pipelines": [
+			{
+				"name": "api",
+				"last_updated_timestamp": 1667376000000,
+				"locked": true,
+				"can_pause": true,
+				"pause_info": {"paused": false, "paused_by": null, "pause_reason": null},
+				"_embedded": {"instances": [
+					{"label": "41", "counter": 41, "triggered_by": "changes", "scheduled_at": "2022-11-02T08:00:00Z", "_embedded": {"stages": [
+						{"name": "build", "counter": "1", "status": "Failed", "approved_by": "changes", "scheduled_at": "2022-11-02T08:00:00Z"}
+					]}},
+					{"label": "42", "counter": 42, "triggered_by": "alice", "scheduled_at": "2022-11-02T09:00:00Z", "_embedded": {"stages": [
+						{"name": "build", "counter": "1", "status": "Passed", "approved_by": "alice", "scheduled_at": "2022-11-02T09:00:00Z"},
+						{"name": "test", "counter": "2", "status": "Failing", "approved_by": "alice", "scheduled_at": "2022-11-02T09:05:00Z"}
+					]}}
+				]}
+			},
+			{
+				"name": "web",
+				"last_updated_timestamp": 1667376000000,
+				"locked": false,
+				"pause_info": {"paused": true, "paused_by": "bob", "pause_reason": "migration", "paused_at": "2022-11-02T07:00:00Z"},
+				"_embedded": {"instances": [
+					{"label": "7", "counter": 7, "triggered_by": "changes", "scheduled_at": "2022-11-02T08:00:00Z", "_embedded": {"stages": [
+						{"name": "deploy", "counter": "1", "status": "Passed", "approved_by": "changes", "scheduled_at": "2022-11-02T08:00:00Z"}
+					]}}
+				]}
+			},
+			{"name": "batch", "pause_info": {"paused": false}, "_embedded": {"instances": []}}
+		]
+	}
+}`
+
+func names(pipelines []types.DashboardPipeline) []string {
+	var names []string
+	for _, p := range pipelines {
+		names = append(names, p.Name)
+	}
+
+	return names
+}
+
+func TestGetDashboard(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/dashboard", r.URL.Path)
+		assert.Equal(t, constants.AcceptV4, r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(dashboard))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := GetDashboard(context.TODO(), client.NewClient(url))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"api", "web"}, names(got.PipelinesInGroup("team-a")))
+	assert.Equal(t, []string{"web"}, names(got.PipelinesInEnvironment("prod")))
+	assert.Empty(t, got.PipelinesInGroup("unknown"))
+	assert.Equal(t, []string{"api"}, names(got.FailingPipelines()))
+
+	api := got.Embedded.Pipelines[0]
+	assert.True(t, api.Locked)
+	assert.Equal(t, types.Counter(42), api.LatestInstance().Counter)
+	assert.Equal(t, types.StageStateFailing, api.LatestInstance().Embedded.Stages[1].Status)
+
+	web := got.Embedded.Pipelines[1]
+	assert.True(t, web.PauseInfo.Paused)
+	assert.Equal(t, "migration", web.PauseInfo.PauseReason)
+	require.NotNil(t, web.PauseInfo.PausedAt)
+
+	assert.Nil(t, got.Embedded.Pipelines[2].LatestInstance())
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelinegroups"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/admin/export/pipelines"
+)
+
+func exportEndpoint(pipeline, pluginId string) string {
+	return endpoint + "/" + url.PathEscape(pipeline) + "?" + url.Values{"plugin_id": []string{pluginId}}.Encode()
+}
+
+// filename returns the file name suggested by the Content-Disposition header, reduced to
+// its base name so it cannot escape the directory it is written to.
+func filename(header http.Header) string {
+	_, params, err := mime.ParseMediaType(header.Get("Content-Disposition"))
+	if err != nil {
+		return ""
+	}
+
+	name := filepath.Base(params["filename"])
+	if name == "." || name == ".." || name == string(filepath.Separator) {
+		return ""
+	}
+
+	return name
+}
+
+func ExportPipeline(ctx context.Context, c *client.Client, pipeline, pluginId string) (*types.PipelineExport, error) {
+	res, err := client.Do(ctx, c, &client.Request{
+		Method:   http.MethodGet,
+		Endpoint: exportEndpoint(pipeline, pluginId),
+		Accept:   constants.AcceptV1,
+		Module:   "export",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PipelineExport{
+		Filename:    filename(res.Header),
+		ContentType: res.Header.Get("Content-Type"),
+		Content:     res.Body,
+	}, nil
+}
+
+// ExportPipelineGroup exports every pipeline of the group into dir, each one in the file
+// its plugin suggests, and returns the paths written. It stops at the first failure, the
+// files written until then are kept. Two pipelines suggesting the same file are a failure,
+// neither overwrites the other.
+func ExportPipelineGroup(ctx context.Context, c *client.Client, group, pluginId, dir string) ([]string, error) {
+	pipelineGroup, err := pipelinegroups.GetPipelineGroup(ctx, c, group)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(pipelineGroup.Pipelines))
+	// keyed case-insensitively, such names are the same file on macOS and Windows
+	exported := make(map[string]string, len(pipelineGroup.Pipelines))
+
+	for _, pipeline := range pipelineGroup.Pipelines {
+		export, err := ExportPipeline(ctx, c, pipeline.Name, pluginId)
+		if err != nil {
+			return paths, fmt.Errorf("failed to export pipeline '%s': '%w'", pipeline.Name, err)
+		}
+
+		name := export.Filename
+		if name == "" {
+			return paths, fmt.Errorf("no file name suggested for pipeline '%s'", pipeline.Name)
+		}
+
+		if other, ok := exported[strings.ToLower(name)]; ok {
+			return paths, fmt.Errorf("pipelines '%s' and '%s' both export to '%s'", other, pipeline.Name, name)
+		}
+
+		exported[strings.ToLower(name)] = pipeline.Name
+		path := filepath.Join(dir, name)
+
+		err = os.WriteFile(path, export.Content, 0o644) //nolint:gosec // config files are not secrets
+		if err != nil {
+			return paths, fmt.Errorf("failed to write pipeline '%s': '%w'", pipeline.Name, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,94 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportPipelineGroup(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/admin/pipeline_groups/team-a":
+			_, _ = w.Write([]byte(`{"name": "team-a", "pipelines": [{"name": "api"}, {"name": "web"}]}`))
+		case "/api/admin/export/pipelines/api", "/api/admin/export/pipelines/web":
+			assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+			assert.Equal(t, types.ConfigRepoPluginYAML, r.URL.Query().Get("plugin_id"))
+
+			name := filepath.Base(r.URL.Path)
+			w.Header().Set("Content-Type", "application/x-yaml; charset=utf-8")
+			w.Header().Set("Content-Disposition", `attachment; filename="../`+name+`.gocd.yaml"`)
+			_, _ = w.Write([]byte("format_version: 10\npipelines:\n  " + name + ": {}\n"))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	dir := t.TempDir()
+
+	paths, err := ExportPipelineGroup(context.TODO(), client.NewClient(url), "team-a", types.ConfigRepoPluginYAML, dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "api.gocd.yaml"), filepath.Join(dir, "web.gocd.yaml")}, paths)
+
+	content, err := os.ReadFile(paths[1])
+	require.NoError(t, err)
+	assert.Equal(t, "format_version: 10\npipelines:\n  web: {}\n", string(content))
+}
+
+func TestExportPipelineGroupFilenameConflict(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/admin/pipeline_groups/team-a":
+			_, _ = w.Write([]byte(`{"name": "team-a", "pipelines": [{"name": "api"}, {"name": "API"}]}`))
+		default:
+			w.Header().Set("Content-Disposition", `attachment; filename="api.gocd.yaml"`)
+			_, _ = w.Write([]byte("pipelines:\n  " + filepath.Base(r.URL.Path) + ": {}\n"))
+		}
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	dir := t.TempDir()
+
+	paths, err := ExportPipelineGroup(context.TODO(), client.NewClient(url), "team-a", types.ConfigRepoPluginYAML, dir)
+	require.EqualError(t, err, "pipelines 'api' and 'API' both export to 'api.gocd.yaml'")
+	assert.Equal(t, []string{filepath.Join(dir, "api.gocd.yaml")}, paths)
+
+	content, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, "pipelines:\n  api: {}\n", string(content))
+}
+
+func TestExportPipelineWithoutFilename(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "api"}`))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+
+	export, err := ExportPipeline(context.TODO(), client.NewClient(url), "api", types.ConfigRepoPluginJSON)
+	require.NoError(t, err)
+	assert.Empty(t, export.Filename)
+	assert.Equal(t, "application/json", export.ContentType)
+	assert.JSONEq(t, `{"name": "api"}`, string(export.Content))
+}
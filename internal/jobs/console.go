@@ -0,0 +1,168 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const defaultPollInterval = 2 * time.Second
+
+func consoleLogEndpoint(pipeline string, pipelineCounter int, stage string, stageCounter int, job string) string {
+	return "/files/" + url.PathEscape(pipeline) + "/" + strconv.Itoa(pipelineCounter) + "/" +
+		url.PathEscape(stage) + "/" + strconv.Itoa(stageCounter) + "/" + url.PathEscape(job) + "/cruise-output/console.log"
+}
+
+// consoleFollower polls the console log of a job and writes every new byte to a pipe.
+type consoleFollower struct {
+	c        *client.Client
+	pipeline string
+	pCounter int
+	stage    string
+	sCounter int
+	job      string
+	interval time.Duration
+	offset   int64
+	w        *io.PipeWriter
+}
+
+// followReader is the read end handed to the caller, closing it stops the polling.
+type followReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *followReader) Close() error {
+	r.cancel()
+
+	return r.PipeReader.Close()
+}
+
+// FollowConsoleLog returns the console output of the job as it is written, the reader
+// reaches io.EOF once the job completed and its whole log was read. A log that does not
+// exist yet, e.g. while the job waits for an agent, is polled for until it shows up.
+// Cancelling ctx or closing the reader stops the polling, Read then fails with ctx.Err().
+func FollowConsoleLog(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job string, opts *types.ConsoleLogOptions) io.ReadCloser {
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+
+	f := &consoleFollower{
+		c:        c,
+		pipeline: pipeline,
+		pCounter: pipelineCounter,
+		stage:    stage,
+		sCounter: stageCounter,
+		job:      job,
+		interval: defaultPollInterval,
+		w:        pw,
+	}
+
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			f.interval = opts.PollInterval
+		}
+
+		f.offset = opts.Offset
+	}
+
+	// a cancelled ctx also unblocks a write nobody reads anymore
+	context.AfterFunc(ctx, func() {
+		pw.CloseWithError(ctx.Err())
+	})
+
+	go func() {
+		defer cancel()
+
+		pw.CloseWithError(f.follow(ctx))
+	}()
+
+	return &followReader{PipeReader: pr, cancel: cancel}
+}
+
+// follow returns nil, closing the pipe with io.EOF, once the log of the completed job was
+// read to the end.
+func (f *consoleFollower) follow(ctx context.Context) error {
+	for {
+		n, err := f.poll(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n == 0 {
+			instance, err := GetJobInstance(ctx, f.c, f.pipeline, f.pCounter, f.stage, f.sCounter, f.job)
+			if err != nil {
+				return err
+			}
+
+			// the job may have written its last lines between the poll and the state
+			// check, read once more before stopping
+			if instance.State == types.JobStateCompleted {
+				_, err = f.poll(ctx)
+
+				return err
+			}
+		}
+
+		if err := client.Sleep(ctx, f.interval); err != nil {
+			return err
+		}
+	}
+}
+
+// poll copies the bytes of the log past the current offset to the pipe and returns how
+// many there were. A missing log, or no new bytes, is not an error.
+func (f *consoleFollower) poll(ctx context.Context) (int64, error) {
+	r := &client.Request{
+		Method:   http.MethodGet,
+		Endpoint: consoleLogEndpoint(f.pipeline, f.pCounter, f.stage, f.sCounter, f.job),
+		Accept:   "text/plain",
+		Module:   "jobs",
+	}
+
+	if f.offset > 0 {
+		r.Header = http.Header{"Range": []string{fmt.Sprintf("bytes=%d-", f.offset)}}
+	}
+
+	// expected on every poll while the job waits for an agent or has no new output
+	res, err := client.StreamExpecting(ctx, f.c, r, http.StatusNotFound, http.StatusRequestedRangeNotSatisfiable)
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		_, _ = io.Copy(io.Discard, res.Body)
+
+		return 0, nil
+	}
+
+	// a server ignoring the range sends the whole log again
+	if res.StatusCode != http.StatusPartialContent && f.offset > 0 {
+		_, err = io.CopyN(io.Discard, res.Body, f.offset)
+		if errors.Is(err, io.EOF) {
+			return 0, nil
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf("failed to read console log: '%w'", err)
+		}
+	}
+
+	n, err := io.Copy(f.w, res.Body)
+	f.offset += n
+
+	if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+		err = fmt.Errorf("failed to read console log: '%w'", err)
+	}
+
+	return n, err
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/jobs"
+)
+
+func instanceEndpoint(pipeline string, pipelineCounter int, stage string, stageCounter int, job string) string {
+	return endpoint + "/" + url.PathEscape(pipeline) + "/" + strconv.Itoa(pipelineCounter) + "/" +
+		url.PathEscape(stage) + "/" + strconv.Itoa(stageCounter) + "/" + url.PathEscape(job)
+}
+
+func historyEndpoint(pipeline, stage, job string, opts *types.PageOptions) (string, error) {
+	e := endpoint + "/" + url.PathEscape(pipeline) + "/" + url.PathEscape(stage) + "/" + url.PathEscape(job) + "/history"
+	if opts == nil {
+		return e, nil
+	}
+
+	return client.PageEndpoint(e, opts.PageSize, opts.After, opts.Before)
+}
+
+func GetJobInstance(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) (*types.JobInstance, error) {
+	return client.Get[types.JobInstance](ctx, c, instanceEndpoint(pipeline, pipelineCounter, stage, stageCounter, job), constants.AcceptV1, "jobs")
+}
+
+func getHistory(ctx context.Context, c *client.Client, e string) (*types.JobHistory, error) {
+	return client.Get[types.JobHistory](ctx, c, e, constants.AcceptV1, "jobs")
+}
+
+// GetJobHistory returns a single page of runs of the job, across pipeline runs, newest first.
+func GetJobHistory(ctx context.Context, c *client.Client, pipeline, stage, job string, opts *types.PageOptions) (*types.JobHistory, error) {
+	e, err := historyEndpoint(pipeline, stage, job, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return getHistory(ctx, c, e)
+}
+
+// JobHistory walks every run of the job, newest first, following the next page links.
+func JobHistory(c *client.Client, pipeline, stage, job string, opts *types.PageOptions) *client.Pager[types.JobInstance] {
+	limit := 0
+	if opts != nil {
+		limit = opts.Limit
+	}
+
+	e, err := historyEndpoint(pipeline, stage, job, opts)
+	if err != nil {
+		return client.NewStaticPager[types.JobInstance](nil, err)
+	}
+
+	return client.NewPager(e, limit, client.GetPage(c, constants.AcceptV1, "jobs", func(history *types.JobHistory) ([]types.JobInstance, string) {
+		return history.Jobs, history.Links.NextHref()
+	}))
+}
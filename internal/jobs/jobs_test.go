@@ -0,0 +1,159 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const job = `{
+	"name": "unit tests",
+	"state": "Completed",
+	"result": "Passed",
+	"original_job_id": null,
+	"scheduled_date": 1667376000000,
+	"rerun": true,
+	"agent_uuid": "7d1d6a31-4e3a-43b8-9a3c-9b1f5e4a1d3e",
+	"pipeline_name": "build",
+	"pipeline_counter": 12,
+	"stage_name": "test",
+	"stage_counter": "2",
+	"job_state_transitions": [
+		{"state": "Scheduled", "state_change_time": 1667376000000},
+		{"state": "Assigned", "state_change_time": 1667376030000},
+		{"state": "Preparing", "state_change_time": 1667376031000},
+		{"state": "Building", "state_change_time": 1667376040000},
+		{"state": "Completing", "state_change_time": 1667376340000},
+		{"state": "Completed", "state_change_time": 1667376345000}
+	]
+}`
+
+func TestGetJobInstance(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/jobs/build/12/test/2/unit tests", r.URL.Path)
+		assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(job))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := GetJobInstance(context.TODO(), client.NewClient(url), "build", 12, "test", 2, "unit tests")
+	require.NoError(t, err)
+
+	assert.True(t, got.Rerun)
+	assert.Nil(t, got.OriginalJobId)
+	assert.Equal(t, "7d1d6a31-4e3a-43b8-9a3c-9b1f5e4a1d3e", got.AgentUUID)
+	assert.Equal(t, types.Counter(2), got.StageCounter)
+	require.Len(t, got.StateTransitions, 6)
+	assert.Equal(t, types.JobStateBuilding, got.StateTransitions[3].State)
+}
+
+func TestJobHistory(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/jobs/build/test/unit tests/history", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("page_size"))
+
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{"_links": {"next": {"href": "http://gocd/go/api/jobs/build/test/unit%20tests/history?after=3"}}, "jobs": [` + job + `]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"_links": {}, "jobs": [{"name": "unit tests", "state": "Completed", "result": "Failed"}]}`))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	pager := JobHistory(client.NewClient(url), "build", "test", "unit tests", &types.PageOptions{PageSize: 10})
+
+	var results []types.JobResult
+	for pager.Next(context.TODO()) {
+		results = append(results, pager.Value().Result)
+	}
+
+	require.NoError(t, pager.Err())
+	assert.Equal(t, []types.JobResult{types.JobResultPassed, types.JobResultFailed}, results)
+}
+
+func TestFollowConsoleLog(t *testing.T) {
+	t.Parallel()
+
+	// the log does not exist until the job is assigned, then grows until it completes
+	logs := []string{"", "line 1\n", "line 1\nline 2\n"}
+
+	var mu sync.Mutex
+	step := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/api/jobs/build/12/test/2/deploy" {
+			step = min(step+1, len(logs)-1)
+			state := types.JobStateBuilding
+			if step == len(logs)-1 {
+				state = types.JobStateCompleted
+			}
+			_, _ = w.Write([]byte(`{"name": "deploy", "state": "` + string(state) + `"}`))
+
+			return
+		}
+
+		assert.Equal(t, "/files/build/12/test/2/deploy/cruise-output/console.log", r.URL.Path)
+		if step == 0 {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		http.ServeContent(w, r, "console.log", time.Time{}, bytes.NewReader([]byte(logs[step])))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	log := FollowConsoleLog(context.TODO(), client.NewClient(url), "build", 12, "test", 2, "deploy", &types.ConsoleLogOptions{PollInterval: time.Millisecond})
+	defer log.Close()
+
+	got, err := io.ReadAll(log)
+	require.NoError(t, err)
+	assert.Equal(t, "line 1\nline 2\n", string(got))
+}
+
+func TestFollowConsoleLogCanceled(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/jobs/build/12/test/2/deploy" {
+			_, _ = w.Write([]byte(`{"name": "deploy", "state": "Assigned"}`))
+
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	url, _ := url.Parse(ts.URL)
+	log := FollowConsoleLog(ctx, client.NewClient(url), "build", 12, "test", 2, "deploy", &types.ConsoleLogOptions{PollInterval: time.Millisecond})
+	defer log.Close()
+
+	_, err := io.ReadAll(log)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -1,52 +1,74 @@
 package logging
 
 import (
-	"context"
+	"log/slog"
 	"os"
-	"strings"
-
-	nested "github.com/antonfisher/nested-logrus-formatter"
-	"github.com/sirupsen/logrus"
 )
 
-// Logger is a custom logger type embedding logrus.Logger
-type Logger struct {
-	*logrus.Entry
+// Logger is the structured logger used by the client, args are alternating key/value pairs.
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+	With(args ...any) Logger
 }
 
-func (l *Logger) SetDebug() {
-	l.Logger.SetLevel(logrus.DebugLevel)
-	l.Logger.SetReportCaller(true)
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func (n nopLogger) With(...any) Logger {
+	return n
 }
 
-func newLogger() *logrus.Logger {
-	logger := logrus.New()
+// Nop returns a Logger that discards everything, it is the client's default.
+func Nop() Logger {
+	return nopLogger{}
+}
 
-	logger.SetFormatter(
-		&nested.Formatter{
-			HideKeys:      true,
-			ShowFullLevel: true,
-			FieldsOrder:   []string{"MODULE", "METHOD", "URL"},
-		},
-	)
+type slogLogger struct {
+	logger *slog.Logger
+}
 
-	logger.SetOutput(os.Stdout)
+func (s *slogLogger) Debug(msg string, args ...any) {
+	s.logger.Debug(msg, args...)
+}
 
-	return logger
+func (s *slogLogger) Info(msg string, args ...any) {
+	s.logger.Info(msg, args...)
 }
 
-func NewLogger() *Logger {
-	logger := newLogger()
+func (s *slogLogger) Warn(msg string, args ...any) {
+	s.logger.Warn(msg, args...)
+}
 
-	return &Logger{
-		logger.WithContext(context.TODO()),
-	}
+func (s *slogLogger) Error(msg string, args ...any) {
+	s.logger.Error(msg, args...)
 }
 
-func NewLoggerWithModule(module string) *Logger {
-	logger := newLogger()
+func (s *slogLogger) With(args ...any) Logger {
+	return &slogLogger{s.logger.With(args...)}
+}
 
-	return &Logger{
-		logger.WithField("MODULE", strings.ToUpper(module)),
+// NewSlogLogger adapts a *slog.Logger, a nil logger uses slog.Default().
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
 	}
+
+	return &slogLogger{logger}
+}
+
+// NewDebugLogger writes every record, including debug ones, to stderr.
+func NewDebugLogger() Logger {
+	handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     slog.LevelDebug,
+	})
+
+	return NewSlogLogger(slog.New(handler))
 }
@@ -1,6 +1,8 @@
 package packages
 
 import (
+	"context"
+
 	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
 	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
@@ -10,26 +12,30 @@ const (
 	endpoint = "/api/admin/packages"
 )
 
-func GetAllPackages(c *client.Client) (*types.AllPackages, error) {
-	return client.Get[types.AllPackages](c, endpoint, constants.AcceptV2, "packages")
+func GetAllPackages(ctx context.Context, c *client.Client) (*types.AllPackages, error) {
+	return client.Get[types.AllPackages](ctx, c, endpoint, constants.AcceptV2, "packages")
+}
+
+func GetPackage(ctx context.Context, c *client.Client, packageId string) (*types.Package, error) {
+	return client.Get[types.Package](ctx, c, endpoint+"/"+packageId, constants.AcceptV2, "packages")
 }
 
-func GetPackage(c *client.Client, packageId string) (*types.Package, error) {
-	return client.Get[types.Package](c, endpoint+"/"+packageId, constants.AcceptV2, "packages")
+func GetPackageWithETag(ctx context.Context, c *client.Client, packageId string) (*types.Package, string, error) {
+	return client.GetWithETag[types.Package](ctx, c, endpoint+"/"+packageId, constants.AcceptV2, "packages")
 }
 
-func GetPackageWithETag(c *client.Client, packageId string) (*types.Package, string, error) {
-	return client.GetWithETag[types.Package](c, endpoint+"/"+packageId, constants.AcceptV2, "packages")
+func CreatePackage(ctx context.Context, c *client.Client, pkg *types.Package) (*types.Package, error) {
+	return client.Post[types.Package, types.Package](ctx, c, pkg, endpoint, constants.AcceptV2, "packages")
 }
 
-func CreatePackage(c *client.Client, pkg *types.Package) (*types.Package, error) {
-	return client.Post[types.Package, types.Package](c, pkg, endpoint, constants.AcceptV2, "packages")
+func UpdatePackage(ctx context.Context, c *client.Client, pkg *types.Package, eTag string) (*types.Package, error) {
+	return client.Put[types.Package, types.Package](ctx, c, pkg, eTag, endpoint+"/"+pkg.Id, constants.AcceptV2, "packages")
 }
 
-func UpdatePackage(c *client.Client, pkg *types.Package, eTag string) (*types.Package, error) {
-	return client.Put[types.Package, types.Package](c, pkg, eTag, endpoint+"/"+pkg.Id, constants.AcceptV2, "packages")
+func DeletePackage(ctx context.Context, c *client.Client, packageId string) (string, error) {
+	return client.Delete(ctx, c, endpoint+"/"+packageId, constants.AcceptV2, "packages")
 }
 
-func DeletePackage(c *client.Client, packageId string) (string, error) {
-	return client.Delete(c, endpoint+"/"+packageId, constants.AcceptV2, "packages")
+func ModifyPackage(ctx context.Context, c *client.Client, packageId string, mutate func(*types.Package) error) (*types.Package, error) {
+	return client.Modify(ctx, c, endpoint+"/"+packageId, constants.AcceptV2, "packages", mutate)
 }
@@ -0,0 +1,58 @@
+package pipelinegroups
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/admin/pipeline_groups"
+)
+
+func groupEndpoint(name string) string {
+	return endpoint + "/" + url.PathEscape(name)
+}
+
+func GetAllPipelineGroups(ctx context.Context, c *client.Client) (*types.AllPipelineGroups, error) {
+	return client.Get[types.AllPipelineGroups](ctx, c, endpoint, constants.AcceptV1, "pipelinegroups")
+}
+
+func GetPipelineGroup(ctx context.Context, c *client.Client, name string) (*types.PipelineGroup, error) {
+	return client.Get[types.PipelineGroup](ctx, c, groupEndpoint(name), constants.AcceptV1, "pipelinegroups")
+}
+
+func GetPipelineGroupWithETag(ctx context.Context, c *client.Client, name string) (*types.PipelineGroup, string, error) {
+	return client.GetWithETag[types.PipelineGroup](ctx, c, groupEndpoint(name), constants.AcceptV1, "pipelinegroups")
+}
+
+func CreatePipelineGroup(ctx context.Context, c *client.Client, group *types.PipelineGroup) (*types.PipelineGroup, error) {
+	return client.Post[types.PipelineGroup, types.PipelineGroup](ctx, c, group, endpoint, constants.AcceptV1, "pipelinegroups")
+}
+
+func UpdatePipelineGroup(ctx context.Context, c *client.Client, group *types.PipelineGroup, eTag string) (*types.PipelineGroup, error) {
+	return client.Put[types.PipelineGroup, types.PipelineGroup](ctx, c, group, eTag, groupEndpoint(group.Name), constants.AcceptV1, "pipelinegroups")
+}
+
+func DeletePipelineGroup(ctx context.Context, c *client.Client, name string) (string, error) {
+	return client.Delete(ctx, c, groupEndpoint(name), constants.AcceptV1, "pipelinegroups")
+}
+
+func ModifyPipelineGroup(ctx context.Context, c *client.Client, name string, mutate func(*types.PipelineGroup) error) (*types.PipelineGroup, error) {
+	return client.Modify(ctx, c, groupEndpoint(name), constants.AcceptV1, "pipelinegroups", mutate)
+}
+
+func GrantPipelineGroupRole(ctx context.Context, c *client.Client, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error) {
+	return ModifyPipelineGroup(ctx, c, name, func(group *types.PipelineGroup) error {
+		return group.GrantRole(permission, role)
+	})
+}
+
+func RevokePipelineGroupRole(ctx context.Context, c *client.Client, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error) {
+	return ModifyPipelineGroup(ctx, c, name, func(group *types.PipelineGroup) error {
+		return group.RevokeRole(permission, role)
+	})
+}
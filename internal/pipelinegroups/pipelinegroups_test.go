@@ -0,0 +1,57 @@
+package pipelinegroups
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const group = `{
+	"name": "team-a",
+	"authorization": {
+		"view": {"users": ["alice"], "roles": []},
+		"admins": {"users": [], "roles": ["leads"]}
+	},
+	"pipelines": [{"name": "build"}]
+}`
+
+func TestGrantPipelineGroupRole(t *testing.T) {
+	t.Parallel()
+
+	var updated types.PipelineGroup
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/pipeline_groups/team-a", r.URL.Path)
+		assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(group))
+		case http.MethodPut:
+			assert.Equal(t, `"v1"`, r.Header.Get("If-Match"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			w.Header().Set("ETag", `"v2"`)
+			_ = json.NewEncoder(w).Encode(updated)
+		}
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := GrantPipelineGroupRole(context.TODO(), client.NewClient(url), "team-a", types.PipelineGroupView, "developers")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"alice"}, updated.Authorization.View.Users)
+	assert.Equal(t, []string{"developers"}, updated.Authorization.View.Roles)
+	assert.Equal(t, []string{"leads"}, updated.Authorization.Admins.Roles)
+	assert.Nil(t, updated.Authorization.Operate)
+	assert.Equal(t, []types.PipelineGroupPipeline{{Name: "build"}}, got.Pipelines)
+}
@@ -0,0 +1,68 @@
+package pipelineinstances
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/pipelines"
+)
+
+func historyEndpoint(name string, opts *types.PageOptions) (string, error) {
+	e := endpoint + "/" + url.PathEscape(name) + "/history"
+	if opts == nil {
+		return e, nil
+	}
+
+	return client.PageEndpoint(e, opts.PageSize, opts.After, opts.Before)
+}
+
+func getHistory(ctx context.Context, c *client.Client, e string) (*types.PipelineHistory, error) {
+	return client.Get[types.PipelineHistory](ctx, c, e, constants.AcceptV1, "pipelineinstances")
+}
+
+// GetPipelineHistory returns a single page of runs, newest first.
+func GetPipelineHistory(ctx context.Context, c *client.Client, name string, opts *types.PageOptions) (*types.PipelineHistory, error) {
+	e, err := historyEndpoint(name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return getHistory(ctx, c, e)
+}
+
+// PipelineHistory walks every run, newest first, following the next page links.
+func PipelineHistory(c *client.Client, name string, opts *types.PageOptions) *client.Pager[types.PipelineInstance] {
+	limit := 0
+	if opts != nil {
+		limit = opts.Limit
+	}
+
+	e, err := historyEndpoint(name, opts)
+	if err != nil {
+		return client.NewStaticPager[types.PipelineInstance](nil, err)
+	}
+
+	return client.NewPager(e, limit, client.GetPage(c, constants.AcceptV1, "pipelineinstances", func(history *types.PipelineHistory) ([]types.PipelineInstance, string) {
+		return history.Pipelines, history.Links.NextHref()
+	}))
+}
+
+func GetPipelineInstance(ctx context.Context, c *client.Client, name string, counter int) (*types.PipelineInstance, error) {
+	e := endpoint + "/" + url.PathEscape(name) + "/" + strconv.Itoa(counter)
+
+	return client.Get[types.PipelineInstance](ctx, c, e, constants.AcceptV1, "pipelineinstances")
+}
+
+// ComparePipelineInstances returns the material changes between the from and to runs of the pipeline.
+func ComparePipelineInstances(ctx context.Context, c *client.Client, name string, from, to int) (*types.PipelineComparison, error) {
+	e := endpoint + "/" + url.PathEscape(name) + "/compare/" + strconv.Itoa(from) + "/" + strconv.Itoa(to)
+
+	return client.Get[types.PipelineComparison](ctx, c, e, constants.AcceptV2, "pipelineinstances")
+}
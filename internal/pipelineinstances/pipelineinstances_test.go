@@ -0,0 +1,214 @@
+package pipelineinstances
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// historyServer serves runs total..1 of "build", 10 at a time, with next links
+// pointing at a host the client does not know about.
+func historyServer(t *testing.T, total int, requests *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/api/pipelines/build/history", r.URL.Path)
+		assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+		assert.Equal(t, "10", r.URL.Query().Get("page_size"))
+
+		start := total
+		if after := r.URL.Query().Get("after"); after != "" {
+			start, _ = strconv.Atoi(after)
+			start--
+		}
+
+		var runs []string
+		for counter := start; counter > 0 && counter > start-10; counter-- {
+			runs = append(runs, fmt.Sprintf(`{"name": "build", "counter": %d, "stages": []}`, counter))
+		}
+
+		links := `{}`
+		if last := start - len(runs) + 1; last > 1 {
+			links = fmt.Sprintf(`{"next": {"href": "https://gocd.internal/go/api/pipelines/build/history?after=%d"}}`, last)
+		}
+
+		body := fmt.Sprintf(`{"_links": %s, "pipelines": [`, links)
+		for i, run := range runs {
+			if i > 0 {
+				body += ","
+			}
+			body += run
+		}
+
+		_, _ = w.Write([]byte(body + "]}"))
+	}))
+}
+
+func TestPipelineHistory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		limit        int
+		wantCount    int
+		wantRequests int32
+	}{
+		{
+			name:         "Walks every page",
+			wantCount:    25,
+			wantRequests: 3,
+		},
+		{
+			name:         "Stops at the limit",
+			limit:        15,
+			wantCount:    15,
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			ts := historyServer(t, 25, &requests)
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			pager := PipelineHistory(client.NewClient(url), "build", &types.PageOptions{PageSize: 10, Limit: tt.limit})
+
+			var counters []int
+			for pager.Next(context.TODO()) {
+				counters = append(counters, pager.Value().Counter)
+			}
+
+			require.NoError(t, pager.Err())
+			wantCounters := make([]int, 0, tt.wantCount)
+			for counter := 25; len(wantCounters) < tt.wantCount; counter-- {
+				wantCounters = append(wantCounters, counter)
+			}
+			assert.Equal(t, wantCounters, counters)
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestPipelineHistoryError(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "Pipeline 'build' not found."}`, http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	pager := PipelineHistory(client.NewClient(url), "build", nil)
+
+	assert.False(t, pager.Next(context.TODO()))
+	assert.True(t, client.IsNotFound(pager.Err()))
+}
+
+func TestPipelineHistoryInvalidPageSize(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	ts := historyServer(t, 25, &requests)
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	c := client.NewClient(url)
+
+	for _, size := range []int{1, 101} {
+		_, err := GetPipelineHistory(context.TODO(), c, "build", &types.PageOptions{PageSize: size})
+		require.ErrorIs(t, err, client.ErrInvalidPageSize)
+
+		pager := PipelineHistory(c, "build", &types.PageOptions{PageSize: size})
+		assert.False(t, pager.Next(context.TODO()))
+		require.ErrorIs(t, pager.Err(), client.ErrInvalidPageSize)
+	}
+
+	assert.Zero(t, requests.Load())
+}
+
+func TestGetPipelineInstance(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/pipelines/build/7", r.URL.Path)
+		_, _ = w.Write([]byte(`{
+			"name": "build",
+			"counter": 7,
+			"label": "7",
+			"natural_order": 7.0,
+			"can_run": true,
+			"preparing_to_schedule": false,
+			"comment": null,
+			"scheduled_date": 1436519914578,
+			"build_cause": {
+				"trigger_message": "modified by user1",
+				"trigger_forced": false,
+				"approver": "",
+				"material_revisions": [{
+					"changed": true,
+					"material": {"name": "repo", "fingerprint": "fp", "type": "Git", "description": "URL: https://example.com/repo.git"},
+					"modifications": [{"revision": "abc", "modified_time": 1436519914378, "user_name": "user1", "comment": "fix", "email_address": null}]
+				}]
+			},
+			"stages": [{
+				"name": "test",
+				"counter": "2",
+				"scheduled": true,
+				"approval_type": "success",
+				"approved_by": "changes",
+				"result": "Passed",
+				"status": "Passed",
+				"rerun_of_counter": null,
+				"jobs": [{"name": "unit", "scheduled_date": 1436782534378, "state": "Completed", "result": "Passed"}]
+			}]
+		}`))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	run, err := GetPipelineInstance(context.TODO(), client.NewClient(url), "build", 7)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, run.Counter)
+	assert.True(t, run.ScheduledDate.Equal(time.UnixMilli(1436519914578)))
+	assert.Equal(t, "Git", run.BuildCause.MaterialRevisions[0].Material.Type)
+	assert.True(t, run.BuildCause.MaterialRevisions[0].Modifications[0].ModifiedTime.Equal(time.UnixMilli(1436519914378)))
+	require.Len(t, run.Stages, 1)
+	assert.Equal(t, types.Counter(2), run.Stages[0].Counter)
+	assert.Nil(t, run.Stages[0].RerunOfCounter)
+	assert.Equal(t, types.JobStateCompleted, run.Stages[0].Jobs[0].State)
+}
+
+func TestComparePipelineInstances(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/pipelines/deploy/compare/12/15", r.URL.Path)
+		assert.Equal(t, constants.AcceptV2, r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"pipeline_name": "deploy", "from_counter": 12, "to_counter": 15, "changes": [
+			{"material": {"type": "git", "attributes": {"url": "https://example.com/app.git"}}, "revision": [{"revision_sha": "abc", "commit_message": "fix"}]}
+		]}`))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := ComparePipelineInstances(context.TODO(), client.NewClient(url), "deploy", 12, 15)
+	require.NoError(t, err)
+	require.Len(t, got.Changes, 1)
+	assert.Equal(t, "abc", got.Changes[0].Commits[0].Revision)
+}
@@ -0,0 +1,38 @@
+package pipelineops
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/pipelines"
+)
+
+type pause struct {
+	PauseCause string `json:"pause_cause"`
+}
+
+func operationEndpoint(name, operation string) string {
+	return endpoint + "/" + url.PathEscape(name) + "/" + operation
+}
+
+func PausePipeline(ctx context.Context, c *client.Client, name, cause string) (string, error) {
+	return client.MessageText(client.PostConfirm[pause, client.Message](ctx, c, &pause{PauseCause: cause}, operationEndpoint(name, "pause"), constants.AcceptV1, "pipelineops"))
+}
+
+func UnpausePipeline(ctx context.Context, c *client.Client, name string) (string, error) {
+	return client.MessageText(client.PostConfirm[any, client.Message](ctx, c, nil, operationEndpoint(name, "unpause"), constants.AcceptV1, "pipelineops"))
+}
+
+func UnlockPipeline(ctx context.Context, c *client.Client, name string) (string, error) {
+	return client.MessageText(client.PostConfirm[any, client.Message](ctx, c, nil, operationEndpoint(name, "unlock"), constants.AcceptV1, "pipelineops"))
+}
+
+func SchedulePipeline(ctx context.Context, c *client.Client, name string, opts *types.ScheduleOptions) (string, error) {
+	return client.MessageText(client.PostConfirm[types.ScheduleOptions, client.Message](ctx, c, opts, operationEndpoint(name, "schedule"), constants.AcceptV1, "pipelineops"))
+}
@@ -0,0 +1,107 @@
+package pipelineops
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperations(t *testing.T) {
+	t.Parallel()
+
+	updateMaterials := false
+
+	tests := []struct {
+		name     string
+		call     func(c *client.Client) (string, error)
+		wantPath string
+		wantBody string
+	}{
+		{
+			name: "Pause with cause",
+			call: func(c *client.Client) (string, error) {
+				return PausePipeline(context.TODO(), c, "my pipeline", "maintenance")
+			},
+			wantPath: "/api/pipelines/my pipeline/pause",
+			wantBody: `{"pause_cause": "maintenance"}`,
+		},
+		{
+			name: "Unpause without body",
+			call: func(c *client.Client) (string, error) {
+				return UnpausePipeline(context.TODO(), c, "deploy")
+			},
+			wantPath: "/api/pipelines/deploy/unpause",
+		},
+		{
+			name: "Unlock without body",
+			call: func(c *client.Client) (string, error) {
+				return UnlockPipeline(context.TODO(), c, "deploy")
+			},
+			wantPath: "/api/pipelines/deploy/unlock",
+		},
+		{
+			name: "Schedule with overrides",
+			call: func(c *client.Client) (string, error) {
+				return SchedulePipeline(context.TODO(), c, "deploy", &types.ScheduleOptions{
+					EnvironmentVariables: []types.EnvironmentVariable{
+						{Name: "VERSION", Value: "1.2.3"},
+						{Name: "TOKEN", Value: "s3cr3t", Secure: true},
+					},
+					Materials: []types.MaterialRevisionOverride{
+						{Fingerprint: "abc123", Revision: "deadbeef"},
+					},
+					UpdateMaterialsBeforeScheduling: &updateMaterials,
+				})
+			},
+			wantPath: "/api/pipelines/deploy/schedule",
+			wantBody: `{
+				"environment_variables": [
+					{"name": "VERSION", "value": "1.2.3", "secure": false},
+					{"name": "TOKEN", "value": "s3cr3t", "secure": true}
+				],
+				"materials": [{"fingerprint": "abc123", "revision": "deadbeef"}],
+				"update_materials_before_scheduling": false
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+				assert.Equal(t, "true", r.Header.Get("X-GoCD-Confirm"))
+				assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				if tt.wantBody == "" {
+					assert.Empty(t, body)
+					assert.Empty(t, r.Header.Get("Content-Type"))
+				} else {
+					assert.JSONEq(t, tt.wantBody, string(body))
+				}
+
+				w.WriteHeader(http.StatusAccepted)
+				_ = json.NewEncoder(w).Encode(client.Message{Message: "Request to schedule pipeline 'deploy' accepted"})
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			msg, err := tt.call(client.NewClient(url))
+			require.NoError(t, err)
+			assert.Equal(t, "Request to schedule pipeline 'deploy' accepted", msg)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/authentication"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelineinstances"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelineops"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultMaxPollInterval = 30 * time.Second
+	// historyPageSize bounds how many runs may be triggered by others between scheduling
+	// and the first look at the history before ours is missed, GoCD accepts 10 to 100
+	historyPageSize = 20
+	// anonymous is the login GoCD reports when security is disabled
+	anonymous = "anonymous"
+)
+
+// RunError reports a run that did not pass, naming the stage it stopped at and the jobs of
+// that stage that did not pass.
+type RunError struct {
+	Pipeline     string
+	Counter      int
+	Stage        string
+	StageCounter types.Counter
+	Result       types.StageResult
+	FailedJobs   []string
+}
+
+func (e *RunError) Error() string {
+	msg := fmt.Sprintf("pipeline %s/%d: stage %s/%d %s", e.Pipeline, e.Counter, e.Stage, e.StageCounter, strings.ToLower(string(e.Result)))
+	if len(e.FailedJobs) > 0 {
+		msg += ", jobs: " + strings.Join(e.FailedJobs, ", ")
+	}
+
+	return msg
+}
+
+// backoff doubles the poll interval while nothing changes, up to max.
+type backoff struct {
+	initial time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+func (b *backoff) reset() {
+	b.current = b.initial
+}
+
+func (b *backoff) wait(ctx context.Context) error {
+	err := client.Sleep(ctx, b.current)
+	b.current = min(b.current*2, b.max)
+
+	return err
+}
+
+// RunPipeline schedules the pipeline and follows the resulting run until it passes, fails,
+// or stops at a stage waiting for manual approval. A run that did not pass is returned
+// along with a *RunError, on any other error the last instance seen, if any, is returned.
+//
+// GoCD does not tell which run a schedule request created, it is found as the oldest run
+// newer than the ones existing before scheduling that was forced by the authenticated
+// user. Only two runs forced at the same time by the same user can be mistaken for each
+// other.
+func RunPipeline(ctx context.Context, c *client.Client, name string, opts *types.RunPipelineOptions) (*types.PipelineInstance, error) {
+	var o types.RunPipelineOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	b := &backoff{initial: o.PollInterval, max: o.MaxPollInterval}
+	if b.initial <= 0 {
+		b.initial = defaultPollInterval
+	}
+
+	if b.max < b.initial {
+		b.max = max(defaultMaxPollInterval, b.initial)
+	}
+
+	b.reset()
+
+	before, err := latestCounter(ctx, c, name)
+	if err != nil {
+		return nil, err
+	}
+
+	approver, err := currentApprover(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Schedule == nil {
+		o.Schedule = &types.ScheduleOptions{}
+	}
+
+	_, err = pipelineops.SchedulePipeline(ctx, c, name, o.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	counter, err := findRun(ctx, c, name, before, approver, b)
+	if err != nil {
+		return nil, err
+	}
+
+	b.reset()
+
+	return follow(ctx, c, name, counter, o.OnProgress, b)
+}
+
+// currentApprover returns the login runs forced by the authenticated user are approved by.
+// It is empty, making every forced run a candidate, only on a server without security,
+// which has no current user or reports the anonymous one.
+func currentApprover(ctx context.Context, c *client.Client) (string, error) {
+	user, err := authentication.GetCurrentUser(ctx, c)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	if user.LoginName == anonymous {
+		return "", nil
+	}
+
+	return user.LoginName, nil
+}
+
+// latestCounter returns the counter of the newest run, the first of the default sized page.
+func latestCounter(ctx context.Context, c *client.Client, name string) (int, error) {
+	history, err := pipelineinstances.GetPipelineHistory(ctx, c, name, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(history.Pipelines) == 0 {
+		return 0, nil
+	}
+
+	return history.Pipelines[0].Counter, nil
+}
+
+func findRun(ctx context.Context, c *client.Client, name string, before int, approver string, b *backoff) (int, error) {
+	for {
+		history, err := pipelineinstances.GetPipelineHistory(ctx, c, name, &types.PageOptions{PageSize: historyPageSize})
+		if err != nil {
+			return 0, err
+		}
+
+		counter := 0
+
+		for _, run := range history.Pipelines {
+			cause := run.BuildCause
+			if run.Counter <= before || !cause.TriggerForced || (approver != "" && cause.Approver != approver) {
+				continue
+			}
+
+			if counter == 0 || run.Counter < counter {
+				counter = run.Counter
+			}
+		}
+
+		if counter != 0 {
+			return counter, nil
+		}
+
+		err = b.wait(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("waiting for the run of pipeline '%s' to be scheduled: '%w'", name, err)
+		}
+	}
+}
+
+func follow(ctx context.Context, c *client.Client, name string, counter int, onProgress func(*types.PipelineInstance), b *backoff) (*types.PipelineInstance, error) {
+	var seen *types.PipelineInstance
+
+	last := ""
+
+	for {
+		instance, err := pipelineinstances.GetPipelineInstance(ctx, c, name, counter)
+		if err != nil {
+			return seen, err
+		}
+
+		seen = instance
+
+		if current := progress(instance); current != last {
+			last = current
+
+			b.reset()
+
+			if onProgress != nil {
+				onProgress(instance)
+			}
+		}
+
+		done, err := outcome(instance)
+		if done {
+			return instance, err
+		}
+
+		err = b.wait(ctx)
+		if err != nil {
+			return instance, fmt.Errorf("waiting for pipeline '%s/%d' to complete: '%w'", name, counter, err)
+		}
+	}
+}
+
+// progress summarizes the state of every stage and job, it changes whenever one of them does.
+func progress(instance *types.PipelineInstance) string {
+	var sb strings.Builder
+
+	for _, stage := range instance.Stages {
+		fmt.Fprintf(&sb, "%s/%d:%s:%t;", stage.Name, stage.Counter, stage.Status, stage.Scheduled)
+
+		for _, job := range stage.Jobs {
+			fmt.Fprintf(&sb, "%s:%s:%s;", job.Name, job.State, job.Result)
+		}
+	}
+
+	return sb.String()
+}
+
+// outcome reports whether the run is over and, if so, whether it failed. A run stopping at
+// a stage waiting for manual approval is over, the stages before it passed. A run still
+// being prepared, or not listing its stages yet, has not started.
+func outcome(instance *types.PipelineInstance) (bool, error) {
+	if instance.PreparingToSchedule || len(instance.Stages) == 0 {
+		return false, nil
+	}
+
+	for i, stage := range instance.Stages {
+		if !stage.Scheduled {
+			return i > 0 && stage.ApprovalType == types.ApprovalTypeManual, nil
+		}
+
+		if !stage.Status.Done() {
+			return false, nil
+		}
+
+		if stage.Status == types.StageStatePassed {
+			continue
+		}
+
+		runErr := &RunError{
+			Pipeline:     instance.Name,
+			Counter:      instance.Counter,
+			Stage:        stage.Name,
+			StageCounter: stage.Counter,
+			Result:       types.StageResult(stage.Status),
+		}
+
+		for _, job := range stage.Jobs {
+			if job.Result != types.JobResultPassed {
+				runErr.FailedJobs = append(runErr.FailedJobs, job.Name)
+			}
+		}
+
+		return true, runErr
+	}
+
+	return true, nil
+}
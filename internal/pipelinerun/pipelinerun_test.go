@@ -0,0 +1,293 @@
+package pipelinerun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func run(counter int, approver string) string {
+	return fmt.Sprintf(`{"name": "build", "counter": %d, "build_cause": {"trigger_forced": %t, "approver": %q}, "stages": []}`, counter, approver != "", approver)
+}
+
+// fakeGoCD answers with run 4 before "build" is scheduled, runs 5, forced by someone else,
+// and 6 once it was, then with each of the instances in turn, repeating the last, for
+// whichever run is followed.
+type fakeGoCD struct {
+	*httptest.Server
+	mu          sync.Mutex
+	currentUser http.HandlerFunc
+	instances   []string
+	scheduled   bool
+	polls       int
+	followed    string
+}
+
+func loggedIn(login string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"login_name": %q}`, login)
+	}
+}
+
+func status(code int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "no current user"}`, code)
+	}
+}
+
+func runServer(t *testing.T, currentUser http.HandlerFunc, instances ...string) *fakeGoCD {
+	g := &fakeGoCD{currentUser: currentUser, instances: instances}
+	g.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/current_user":
+			g.currentUser(w, r)
+		case "/api/pipelines/build/schedule":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "true", r.Header.Get("X-Gocd-Confirm"))
+			g.scheduled = true
+			_, _ = w.Write([]byte(`{"message": "Request to schedule pipeline build accepted"}`))
+		case "/api/pipelines/build/history":
+			// GoCD rejects page sizes it does not support
+			if size := r.URL.Query().Get("page_size"); size != "" {
+				if n, err := strconv.Atoi(size); err != nil || n < 10 || n > 100 {
+					http.Error(w, `{"message": "The query parameter 'page_size', if specified must have a value between 10 and 100."}`, http.StatusBadRequest)
+
+					return
+				}
+			}
+
+			runs := run(4, "")
+			if g.scheduled {
+				runs = run(6, "alice") + "," + run(5, "bob") + "," + runs
+			}
+
+			_, _ = w.Write([]byte(`{"pipelines": [` + runs + `]}`))
+		case "/api/pipelines/build/5", "/api/pipelines/build/6":
+			g.followed = r.URL.Path
+			_, _ = w.Write([]byte(g.instances[min(g.polls, len(g.instances)-1)]))
+			g.polls++
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+
+	return g
+}
+
+func instance(stages ...string) string {
+	body := `{"name": "build", "counter": 6, "stages": [`
+	for i, stage := range stages {
+		if i > 0 {
+			body += ","
+		}
+		body += stage
+	}
+
+	return body + "]}"
+}
+
+func stage(name, approval, status string, jobs ...string) string {
+	if status == "" {
+		return fmt.Sprintf(`{"name": %q, "scheduled": false, "approval_type": %q, "jobs": []}`, name, approval)
+	}
+
+	body := fmt.Sprintf(`{"name": %q, "counter": "1", "scheduled": true, "approval_type": %q, "status": %q, "result": %q, "jobs": [`, name, approval, status, status)
+	for i, job := range jobs {
+		if i > 0 {
+			body += ","
+		}
+		body += job
+	}
+
+	return body + "]}"
+}
+
+func job(name, state, result string) string {
+	return fmt.Sprintf(`{"name": %q, "state": %q, "result": %q}`, name, state, result)
+}
+
+func TestRunPipeline(t *testing.T) {
+	t.Parallel()
+
+	building := instance(stage("test", "success", "Building", job("unit", "Building", "Unknown")), stage("deploy", "success", ""))
+	tests := []struct {
+		name         string
+		instances    []string
+		wantStages   int
+		wantProgress int
+		wantErr      *RunError
+	}{
+		{
+			name: "Follows every stage",
+			instances: []string{
+				building,
+				building,
+				instance(stage("test", "success", "Passed", job("unit", "Completed", "Passed")), stage("deploy", "success", "")),
+				instance(stage("test", "success", "Passed", job("unit", "Completed", "Passed")), stage("deploy", "success", "Passed", job("rollout", "Completed", "Passed"))),
+			},
+			wantStages:   2,
+			wantProgress: 3,
+		},
+		{
+			name: "Waits for the run to be prepared",
+			instances: []string{
+				`{"name": "build", "counter": 6, "preparing_to_schedule": true, "stages": []}`,
+				instance(),
+				instance(stage("test", "success", "Passed", job("unit", "Completed", "Passed"))),
+			},
+			wantStages:   1,
+			wantProgress: 1,
+		},
+		{
+			name: "Stops at manual approval",
+			instances: []string{
+				instance(stage("test", "success", "Passed", job("unit", "Completed", "Passed")), stage("deploy", "manual", "")),
+			},
+			wantStages:   2,
+			wantProgress: 1,
+		},
+		{
+			name: "Names the failed stage and jobs",
+			instances: []string{
+				building,
+				instance(
+					stage("test", "success", "Failed", job("unit", "Completed", "Failed"), job("lint", "Completed", "Passed")),
+					stage("deploy", "success", ""),
+				),
+			},
+			wantStages:   2,
+			wantProgress: 2,
+			wantErr: &RunError{
+				Pipeline:     "build",
+				Counter:      6,
+				Stage:        "test",
+				StageCounter: 1,
+				Result:       types.StageResultFailed,
+				FailedJobs:   []string{"unit"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := runServer(t, loggedIn("alice"), tt.instances...)
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			progress := 0
+			got, err := RunPipeline(context.TODO(), client.NewClient(url), "build", &types.RunPipelineOptions{
+				PollInterval:    time.Millisecond,
+				MaxPollInterval: 4 * time.Millisecond,
+				OnProgress: func(instance *types.PipelineInstance) {
+					assert.Equal(t, 6, instance.Counter)
+					progress++
+				},
+			})
+
+			if tt.wantErr != nil {
+				var runErr *RunError
+				require.ErrorAs(t, err, &runErr)
+				assert.Equal(t, tt.wantErr, runErr)
+				assert.EqualError(t, err, "pipeline build/6: stage test/1 failed, jobs: unit")
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotNil(t, got)
+			assert.Len(t, got.Stages, tt.wantStages)
+			assert.Equal(t, tt.wantProgress, progress)
+			assert.Equal(t, "/api/pipelines/build/6", ts.followed)
+		})
+	}
+}
+
+func TestRunPipelineCancel(t *testing.T) {
+	t.Parallel()
+
+	ts := runServer(t, loggedIn("alice"), instance(stage("test", "success", "Building", job("unit", "Building", "Unknown"))))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := RunPipeline(ctx, client.NewClient(url), "build", &types.RunPipelineOptions{PollInterval: time.Millisecond})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, errors.As(err, new(*RunError)))
+	require.NotNil(t, got)
+	assert.Equal(t, 6, got.Counter)
+}
+
+func TestRunPipelineCurrentUser(t *testing.T) {
+	t.Parallel()
+
+	passed := instance(stage("test", "success", "Passed", job("unit", "Completed", "Passed")))
+	tests := []struct {
+		name         string
+		currentUser  http.HandlerFunc
+		wantFollowed string
+		wantErr      error
+	}{
+		{
+			name:         "Security disabled, no current user",
+			currentUser:  status(http.StatusNotFound),
+			wantFollowed: "/api/pipelines/build/5",
+		},
+		{
+			name:         "Security disabled, anonymous user",
+			currentUser:  loggedIn("anonymous"),
+			wantFollowed: "/api/pipelines/build/5",
+		},
+		{
+			name:        "Unauthorized",
+			currentUser: status(http.StatusUnauthorized),
+			wantErr:     client.ErrUnauthorized,
+		},
+		{
+			name:        "Server error",
+			currentUser: status(http.StatusInternalServerError),
+			wantErr:     client.ErrServerError,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := runServer(t, tt.currentUser, passed)
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			_, err := RunPipeline(context.TODO(), client.NewClient(url), "build", &types.RunPipelineOptions{PollInterval: time.Millisecond})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				// the run could not be told apart from others, it is not scheduled at all
+				assert.False(t, ts.scheduled)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFollowed, ts.followed)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package pipelines
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/admin/pipelines"
+)
+
+type createPipeline struct {
+	Group    string                `json:"group"`
+	Pipeline *types.PipelineConfig `json:"pipeline"`
+}
+
+func pipelineEndpoint(name string) string {
+	return endpoint + "/" + url.PathEscape(name)
+}
+
+func GetPipelineConfig(ctx context.Context, c *client.Client, name string) (*types.PipelineConfig, error) {
+	return client.Get[types.PipelineConfig](ctx, c, pipelineEndpoint(name), constants.AcceptV11, "pipelines")
+}
+
+func GetPipelineConfigWithETag(ctx context.Context, c *client.Client, name string) (*types.PipelineConfig, string, error) {
+	return client.GetWithETag[types.PipelineConfig](ctx, c, pipelineEndpoint(name), constants.AcceptV11, "pipelines")
+}
+
+func CreatePipelineConfig(ctx context.Context, c *client.Client, group string, pipeline *types.PipelineConfig) (*types.PipelineConfig, error) {
+	payload := &createPipeline{Group: group, Pipeline: pipeline}
+
+	return client.Post[createPipeline, types.PipelineConfig](ctx, c, payload, endpoint, constants.AcceptV11, "pipelines")
+}
+
+func UpdatePipelineConfig(ctx context.Context, c *client.Client, pipeline *types.PipelineConfig, eTag string) (*types.PipelineConfig, error) {
+	return client.Put[types.PipelineConfig, types.PipelineConfig](ctx, c, pipeline, eTag, pipelineEndpoint(pipeline.Name), constants.AcceptV11, "pipelines")
+}
+
+func DeletePipelineConfig(ctx context.Context, c *client.Client, name string) (string, error) {
+	return client.Delete(ctx, c, pipelineEndpoint(name), constants.AcceptV11, "pipelines")
+}
+
+func ModifyPipelineConfig(ctx context.Context, c *client.Client, name string, mutate func(*types.PipelineConfig) error) (*types.PipelineConfig, error) {
+	return client.Modify(ctx, c, pipelineEndpoint(name), constants.AcceptV11, "pipelines", mutate)
+}
@@ -0,0 +1,104 @@
+package pipelines
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pipeline = `{
+	"group": "team-a",
+	"name": "build",
+	"label_template": "${COUNT}",
+	"materials": [{"type": "git", "attributes": {"url": "https://example.com/app.git"}}],
+	"stages": [{"name": "test", "jobs": [{"name": "unit"}]}]
+}`
+
+func TestCreatePipelineConfig(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/admin/pipelines", r.URL.Path)
+		assert.Equal(t, constants.AcceptV11, r.Header.Get("Accept"))
+
+		var body map[string]json.RawMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.JSONEq(t, `"team-a"`, string(body["group"]))
+
+		var created types.PipelineConfig
+		require.NoError(t, json.Unmarshal(body["pipeline"], &created))
+		assert.Equal(t, "build", created.Name)
+
+		_, _ = w.Write([]byte(pipeline))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := CreatePipelineConfig(context.TODO(), client.NewClient(url), "team-a", &types.PipelineConfig{
+		Name:      "build",
+		Materials: []types.Material{{Attributes: &types.GitMaterial{URL: "https://example.com/app.git"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", got.Group)
+}
+
+func TestGetPipelineConfigEscapesName(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/pipelines/build%2Frelease", r.URL.EscapedPath())
+		assert.Equal(t, constants.AcceptV11, r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(pipeline))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := GetPipelineConfig(context.TODO(), client.NewClient(url), "build/release")
+	require.NoError(t, err)
+	assert.Equal(t, "unit", got.Stages[0].Jobs[0].Name)
+}
+
+func TestModifyPipelineConfig(t *testing.T) {
+	t.Parallel()
+
+	var updated types.PipelineConfig
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/pipelines/build", r.URL.Path)
+		assert.Equal(t, constants.AcceptV11, r.Header.Get("Accept"))
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(pipeline))
+		case http.MethodPut:
+			assert.Equal(t, `"v1"`, r.Header.Get("If-Match"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			w.Header().Set("ETag", `"v2"`)
+			_ = json.NewEncoder(w).Encode(updated)
+		}
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	got, err := ModifyPipelineConfig(context.TODO(), client.NewClient(url), "build", func(p *types.PipelineConfig) error {
+		p.LabelTemplate = "1.${COUNT}"
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.${COUNT}", updated.LabelTemplate)
+	require.Len(t, updated.Materials, 1)
+	assert.Equal(t, "https://example.com/app.git", updated.Materials[0].Attributes.(*types.GitMaterial).URL)
+	assert.Equal(t, "1.${COUNT}", got.LabelTemplate)
+}
@@ -0,0 +1,18 @@
+package pipelinestatus
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/pipelines"
+)
+
+func GetPipelineStatus(ctx context.Context, c *client.Client, name string) (*types.PipelineStatus, error) {
+	return client.Get[types.PipelineStatus](ctx, c, endpoint+"/"+url.PathEscape(name)+"/status", constants.AcceptV1, "pipelinestatus")
+}
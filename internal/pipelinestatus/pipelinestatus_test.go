@@ -0,0 +1,68 @@
+package pipelinestatus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPipelineStatus(t *testing.T) {
+	t.Parallel()
+
+	pausedAt := time.Date(2023, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		body string
+		want *types.PipelineStatus
+	}{
+		{
+			name: "Paused",
+			body: `{
+				"paused": true,
+				"paused_cause": "maintenance",
+				"paused_by": "admin",
+				"paused_at": "2023-03-14T09:30:00Z",
+				"locked": false,
+				"schedulable": false
+			}`,
+			want: &types.PipelineStatus{
+				Paused:      true,
+				PausedCause: "maintenance",
+				PausedBy:    "admin",
+				PausedAt:    &pausedAt,
+			},
+		},
+		{
+			name: "Schedulable",
+			body: `{"paused": false, "paused_cause": "", "paused_by": "", "paused_at": null, "locked": true, "schedulable": true}`,
+			want: &types.PipelineStatus{Locked: true, Schedulable: true},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/pipelines/deploy/status", r.URL.Path)
+				assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			got, err := GetPipelineStatus(context.TODO(), client.NewClient(url), "deploy")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package stages
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/stages"
+)
+
+type selectedJobs struct {
+	Jobs []string `json:"jobs"`
+}
+
+func stageEndpoint(pipeline string, pipelineCounter int, stage string) string {
+	return endpoint + "/" + url.PathEscape(pipeline) + "/" + strconv.Itoa(pipelineCounter) + "/" + url.PathEscape(stage)
+}
+
+func instanceEndpoint(pipeline string, pipelineCounter int, stage string, stageCounter int) string {
+	return stageEndpoint(pipeline, pipelineCounter, stage) + "/" + strconv.Itoa(stageCounter)
+}
+
+func historyEndpoint(pipeline, stage string, opts *types.PageOptions) (string, error) {
+	e := endpoint + "/" + url.PathEscape(pipeline) + "/" + url.PathEscape(stage) + "/history"
+	if opts == nil {
+		return e, nil
+	}
+
+	return client.PageEndpoint(e, opts.PageSize, opts.After, opts.Before)
+}
+
+// RunStage triggers a stage of an existing pipeline run, e.g. one waiting for manual approval.
+func RunStage(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string) (string, error) {
+	e := stageEndpoint(pipeline, pipelineCounter, stage) + "/run"
+
+	return client.MessageText(client.PostConfirm[any, client.Message](ctx, c, nil, e, constants.AcceptV2, "stages"))
+}
+
+func CancelStage(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error) {
+	e := instanceEndpoint(pipeline, pipelineCounter, stage, stageCounter) + "/cancel"
+
+	return client.MessageText(client.PostConfirm[any, client.Message](ctx, c, nil, e, constants.AcceptV3, "stages"))
+}
+
+func RunFailedJobs(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error) {
+	e := instanceEndpoint(pipeline, pipelineCounter, stage, stageCounter) + "/run-failed-jobs"
+
+	return client.MessageText(client.PostConfirm[any, client.Message](ctx, c, nil, e, constants.AcceptV3, "stages"))
+}
+
+func RunSelectedJobs(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int, jobs []string) (string, error) {
+	e := instanceEndpoint(pipeline, pipelineCounter, stage, stageCounter) + "/run-selected-jobs"
+
+	return client.MessageText(client.PostConfirm[selectedJobs, client.Message](ctx, c, &selectedJobs{Jobs: jobs}, e, constants.AcceptV3, "stages"))
+}
+
+func GetStageInstance(ctx context.Context, c *client.Client, pipeline string, pipelineCounter int, stage string, stageCounter int) (*types.StageInstance, error) {
+	return client.Get[types.StageInstance](ctx, c, instanceEndpoint(pipeline, pipelineCounter, stage, stageCounter), constants.AcceptV3, "stages")
+}
+
+func getHistory(ctx context.Context, c *client.Client, e string) (*types.StageHistory, error) {
+	return client.Get[types.StageHistory](ctx, c, e, constants.AcceptV3, "stages")
+}
+
+// GetStageHistory returns a single page of runs of the stage, across pipeline runs, newest first.
+func GetStageHistory(ctx context.Context, c *client.Client, pipeline, stage string, opts *types.PageOptions) (*types.StageHistory, error) {
+	e, err := historyEndpoint(pipeline, stage, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return getHistory(ctx, c, e)
+}
+
+// StageHistory walks every run of the stage, newest first, following the next page links.
+func StageHistory(c *client.Client, pipeline, stage string, opts *types.PageOptions) *client.Pager[types.StageInstance] {
+	limit := 0
+	if opts != nil {
+		limit = opts.Limit
+	}
+
+	e, err := historyEndpoint(pipeline, stage, opts)
+	if err != nil {
+		return client.NewStaticPager[types.StageInstance](nil, err)
+	}
+
+	return client.NewPager(e, limit, client.GetPage(c, constants.AcceptV3, "stages", func(history *types.StageHistory) ([]types.StageInstance, string) {
+		return history.Stages, history.Links.NextHref()
+	}))
+}
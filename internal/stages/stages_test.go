@@ -0,0 +1,133 @@
+package stages
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		call       func(c *client.Client) (string, error)
+		wantPath   string
+		wantAccept string
+		wantBody   string
+	}{
+		{
+			name: "Run stage",
+			call: func(c *client.Client) (string, error) {
+				return RunStage(context.TODO(), c, "deploy", 12, "prod")
+			},
+			wantPath:   "/api/stages/deploy/12/prod/run",
+			wantAccept: constants.AcceptV2,
+		},
+		{
+			name: "Cancel stage",
+			call: func(c *client.Client) (string, error) {
+				return CancelStage(context.TODO(), c, "deploy", 12, "prod", 2)
+			},
+			wantPath:   "/api/stages/deploy/12/prod/2/cancel",
+			wantAccept: constants.AcceptV3,
+		},
+		{
+			name: "Run failed jobs",
+			call: func(c *client.Client) (string, error) {
+				return RunFailedJobs(context.TODO(), c, "deploy", 12, "prod", 2)
+			},
+			wantPath:   "/api/stages/deploy/12/prod/2/run-failed-jobs",
+			wantAccept: constants.AcceptV3,
+		},
+		{
+			name: "Run selected jobs",
+			call: func(c *client.Client) (string, error) {
+				return RunSelectedJobs(context.TODO(), c, "deploy", 12, "prod", 2, []string{"smoke", "migrate"})
+			},
+			wantPath:   "/api/stages/deploy/12/prod/2/run-selected-jobs",
+			wantAccept: constants.AcceptV3,
+			wantBody:   `{"jobs": ["smoke", "migrate"]}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+				assert.Equal(t, "true", r.Header.Get("X-GoCD-Confirm"))
+				assert.Equal(t, tt.wantAccept, r.Header.Get("Accept"))
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				if tt.wantBody == "" {
+					assert.Empty(t, body)
+				} else {
+					assert.JSONEq(t, tt.wantBody, string(body))
+				}
+
+				w.WriteHeader(http.StatusAccepted)
+				_ = json.NewEncoder(w).Encode(client.Message{Message: "Request accepted"})
+			}))
+			defer ts.Close()
+
+			url, _ := url.Parse(ts.URL)
+			msg, err := tt.call(client.NewClient(url))
+			require.NoError(t, err)
+			assert.Equal(t, "Request accepted", msg)
+		})
+	}
+}
+
+func TestStageHistory(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/stages/deploy/prod/history", r.URL.Path)
+		assert.Equal(t, constants.AcceptV3, r.Header.Get("Accept"))
+
+		if r.URL.Query().Get("after") == "" {
+			_, _ = w.Write([]byte(`{
+				"_links": {"next": {"href": "https://gocd.example.com/go/api/stages/deploy/prod/history?after=41"}},
+				"stages": [{
+					"name": "prod", "counter": 2, "pipeline_name": "deploy", "pipeline_counter": 12,
+					"result": "Failed", "rerun_of_counter": 1,
+					"jobs": [{"name": "migrate", "state": "Completed", "result": "Failed", "scheduled_date": 1436509881733}]
+				}]
+			}`))
+
+			return
+		}
+
+		assert.Equal(t, "41", r.URL.Query().Get("after"))
+		_, _ = w.Write([]byte(`{"_links": {}, "stages": [{"name": "prod", "counter": 1, "pipeline_name": "deploy", "pipeline_counter": 12, "result": "Passed", "jobs": []}]}`))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	pager := StageHistory(client.NewClient(url), "deploy", "prod", nil)
+
+	var stages []types.StageInstance
+	for pager.Next(context.TODO()) {
+		stages = append(stages, pager.Value())
+	}
+	require.NoError(t, pager.Err())
+	require.Len(t, stages, 2)
+
+	assert.Equal(t, types.StageResultFailed, stages[0].Result)
+	assert.Equal(t, types.Counter(1), *stages[0].RerunOfCounter)
+	assert.Equal(t, types.JobResultFailed, stages[0].Jobs[0].Result)
+	assert.Equal(t, types.StageResultPassed, stages[1].Result)
+}
@@ -0,0 +1,66 @@
+package templates
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const (
+	endpoint = "/api/admin/templates"
+)
+
+func templateEndpoint(name string) string {
+	return endpoint + "/" + url.PathEscape(name)
+}
+
+func authorizationEndpoint(name string) string {
+	return templateEndpoint(name) + "/authorization"
+}
+
+func GetAllTemplates(ctx context.Context, c *client.Client) (*types.AllTemplates, error) {
+	return client.Get[types.AllTemplates](ctx, c, endpoint, constants.AcceptV7, "templates")
+}
+
+func GetTemplate(ctx context.Context, c *client.Client, name string) (*types.TemplateConfig, error) {
+	return client.Get[types.TemplateConfig](ctx, c, templateEndpoint(name), constants.AcceptV7, "templates")
+}
+
+func GetTemplateWithETag(ctx context.Context, c *client.Client, name string) (*types.TemplateConfig, string, error) {
+	return client.GetWithETag[types.TemplateConfig](ctx, c, templateEndpoint(name), constants.AcceptV7, "templates")
+}
+
+func CreateTemplate(ctx context.Context, c *client.Client, template *types.TemplateConfig) (*types.TemplateConfig, error) {
+	return client.Post[types.TemplateConfig, types.TemplateConfig](ctx, c, template, endpoint, constants.AcceptV7, "templates")
+}
+
+func UpdateTemplate(ctx context.Context, c *client.Client, template *types.TemplateConfig, eTag string) (*types.TemplateConfig, error) {
+	return client.Put[types.TemplateConfig, types.TemplateConfig](ctx, c, template, eTag, templateEndpoint(template.Name), constants.AcceptV7, "templates")
+}
+
+func DeleteTemplate(ctx context.Context, c *client.Client, name string) (string, error) {
+	return client.Delete(ctx, c, templateEndpoint(name), constants.AcceptV7, "templates")
+}
+
+func ModifyTemplate(ctx context.Context, c *client.Client, name string, mutate func(*types.TemplateConfig) error) (*types.TemplateConfig, error) {
+	return client.Modify(ctx, c, templateEndpoint(name), constants.AcceptV7, "templates", mutate)
+}
+
+func GetTemplateAuthorization(ctx context.Context, c *client.Client, name string) (*types.TemplateAuthorization, error) {
+	return client.Get[types.TemplateAuthorization](ctx, c, authorizationEndpoint(name), constants.AcceptV1, "templates")
+}
+
+func GetTemplateAuthorizationWithETag(ctx context.Context, c *client.Client, name string) (*types.TemplateAuthorization, string, error) {
+	return client.GetWithETag[types.TemplateAuthorization](ctx, c, authorizationEndpoint(name), constants.AcceptV1, "templates")
+}
+
+func UpdateTemplateAuthorization(ctx context.Context, c *client.Client, name string, authorization *types.TemplateAuthorization, eTag string) (*types.TemplateAuthorization, error) {
+	return client.Put[types.TemplateAuthorization, types.TemplateAuthorization](ctx, c, authorization, eTag, authorizationEndpoint(name), constants.AcceptV1, "templates")
+}
+
+func ModifyTemplateAuthorization(ctx context.Context, c *client.Client, name string, mutate func(*types.TemplateAuthorization) error) (*types.TemplateAuthorization, error) {
+	return client.Modify(ctx, c, authorizationEndpoint(name), constants.AcceptV1, "templates", mutate)
+}
@@ -0,0 +1,112 @@
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	url, _ := url.Parse(ts.URL)
+
+	return client.NewClient(url)
+}
+
+func TestGetAllTemplates(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/templates", r.URL.Path)
+		assert.Equal(t, constants.AcceptV7, r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"_embedded": {"templates": [
+			{"name": "service", "can_edit": true, "can_administer": false, "_embedded": {"pipelines": [{"name": "api"}, {"name": "web"}]}}
+		]}}`))
+	})
+
+	all, err := GetAllTemplates(context.TODO(), c)
+	require.NoError(t, err)
+	require.Len(t, all.Embedded.Templates, 1)
+
+	template := all.Embedded.Templates[0]
+	assert.True(t, template.CanEdit)
+	assert.Equal(t, []string{"api", "web"}, template.PipelineNames())
+}
+
+func TestGetTemplate(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/templates/service", r.URL.Path)
+		_, _ = w.Write([]byte(`{"name": "service", "stages": [{
+			"name": "build",
+			"fetch_materials": true,
+			"approval": {"type": "success", "allow_only_on_success": false, "authorization": {"roles": [], "users": []}},
+			"environment_variables": [],
+			"jobs": [{
+				"name": "compile",
+				"run_instance_count": null,
+				"timeout": "never",
+				"environment_variables": [],
+				"resources": [],
+				"tasks": [{"type": "exec", "attributes": {"run_if": ["passed"], "command": "make", "arguments": ["#{target}"]}}],
+				"tabs": [],
+				"artifacts": []
+			}]
+		}]}`))
+	})
+
+	template, err := GetTemplate(context.TODO(), c, "service")
+	require.NoError(t, err)
+	require.Len(t, template.Stages, 1)
+	require.Len(t, template.Stages[0].Jobs, 1)
+	require.Len(t, template.Stages[0].Jobs[0].Tasks, 1)
+
+	exec, ok := template.Stages[0].Jobs[0].Tasks[0].Attributes.(*types.ExecTask)
+	require.True(t, ok)
+	assert.Equal(t, "make", exec.Command)
+}
+
+func TestModifyTemplateAuthorization(t *testing.T) {
+	t.Parallel()
+
+	var updated types.TemplateAuthorization
+	c := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/templates/service/authorization", r.URL.Path)
+		assert.Equal(t, constants.AcceptV1, r.Header.Get("Accept"))
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`{"all_group_admins_are_view_users": true, "admin": {"users": ["alice"], "roles": []}}`))
+		case http.MethodPut:
+			assert.Equal(t, `"v1"`, r.Header.Get("If-Match"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_ = json.NewEncoder(w).Encode(updated)
+		}
+	})
+
+	_, err := ModifyTemplateAuthorization(context.TODO(), c, "service", func(authorization *types.TemplateAuthorization) error {
+		authorization.View = &types.PermissionTargets{Users: []string{}, Roles: []string{"developers"}}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.True(t, updated.AllGroupAdminsAreViewUsers)
+	assert.Equal(t, []string{"alice"}, updated.Admin.Users)
+	assert.Equal(t, []string{"developers"}, updated.View.Roles)
+}
@@ -0,0 +1,29 @@
+package valuestreammap
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+// the value stream map is served by the GoCD UI endpoints, they are not versioned
+const accept = "application/json"
+
+func pipelineEndpoint(pipeline string, counter int) string {
+	return "/pipelines/value_stream_map/" + url.PathEscape(pipeline) + "/" + strconv.Itoa(counter) + ".json"
+}
+
+func materialEndpoint(fingerprint, revision string) string {
+	return "/materials/value_stream_map/" + url.PathEscape(fingerprint) + "/" + url.PathEscape(revision) + ".json"
+}
+
+func GetPipelineValueStreamMap(ctx context.Context, c *client.Client, pipeline string, counter int) (*types.ValueStreamMap, error) {
+	return client.Get[types.ValueStreamMap](ctx, c, pipelineEndpoint(pipeline, counter), accept, "valuestreammap")
+}
+
+func GetMaterialValueStreamMap(ctx context.Context, c *client.Client, fingerprint, revision string) (*types.ValueStreamMap, error) {
+	return client.Get[types.ValueStreamMap](ctx, c, materialEndpoint(fingerprint, revision), accept, "valuestreammap")
+}
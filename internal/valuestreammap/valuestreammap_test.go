@@ -0,0 +1,95 @@
+package valuestreammap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// git -> build -> test -> deploy, with lib feeding deploy through a dummy node
+const vsm = `{
+	"current_pipeline": "test",
+	"levels": [
+		{"nodes": [
+			{"id": "git-1", "name": "https://example.com/app.git", "node_type": "GIT", "parents": [], "dependents": ["build"], "depth": 1,
+			 "material_names": ["app"], "material_revisions": [{"modifications": [{"revision": "abc123", "user": "alice", "comment": "fix", "modified_time": "2022-11-02T08:00:00Z"}]}]},
+			{"id": "git-2", "name": "https://example.com/lib.git", "node_type": "GIT", "parents": [], "dependents": ["dummy-1"], "depth": 2}
+		]},
+		{"nodes": [
+			{"id": "build", "name": "build", "node_type": "PIPELINE", "parents": ["git-1"], "dependents": ["test"], "depth": 1,
+			 "instances": [{"counter": 12, "label": "12", "stages": [{"name": "compile", "status": "Passed"}]}]},
+			{"id": "dummy-1", "name": "dummy-1", "node_type": "DUMMY", "parents": ["git-2"], "dependents": ["deploy"], "depth": 2}
+		]},
+		{"nodes": [
+			{"id": "test", "name": "test", "node_type": "PIPELINE", "parents": ["build"], "dependents": ["deploy"], "depth": 1,
+			 "instances": [{"counter": "4", "label": "4", "stages": [{"name": "unit", "status": "Passed"}]}]}
+		]},
+		{"nodes": [
+			{"id": "deploy", "name": "deploy", "node_type": "PIPELINE", "parents": ["test", "dummy-1"], "dependents": [], "depth": 1}
+		]}
+	]
+}`
+
+func ids(nodes []*types.VSMNode) []string {
+	var ids []string
+	for _, n := range nodes {
+		ids = append(ids, n.Id)
+	}
+
+	return ids
+}
+
+func TestGetPipelineValueStreamMap(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/pipelines/value_stream_map/test/4.json", r.URL.Path)
+		_, _ = w.Write([]byte(vsm))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	m, err := GetPipelineValueStreamMap(context.TODO(), client.NewClient(url), "test", 4)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"git-1"}, ids(m.UpstreamMaterials(m.CurrentPipeline)))
+	assert.Equal(t, []string{"build"}, ids(m.UpstreamPipelines(m.CurrentPipeline)))
+	assert.Equal(t, []string{"deploy"}, ids(m.DownstreamPipelines(m.CurrentPipeline)))
+	assert.Equal(t, []string{"git-2", "git-1"}, ids(m.UpstreamMaterials("deploy")))
+	assert.Nil(t, m.UpstreamMaterials("unknown"))
+
+	git := m.Node("git-1")
+	require.NotNil(t, git)
+	assert.True(t, git.IsMaterial())
+	assert.Equal(t, "abc123", git.MaterialRevisions[0].Modifications[0].Revision)
+	assert.Equal(t, types.Counter(4), m.Node("test").Instances[0].Counter)
+	assert.Equal(t, 3, m.Node("deploy").Level)
+
+	assert.Contains(t, m.Edges(), types.VSMEdge{From: "dummy-1", To: "deploy", FromLevel: 1, ToLevel: 3})
+	assert.Len(t, m.Edges(), 5)
+}
+
+func TestGetMaterialValueStreamMap(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/materials/value_stream_map/git-1/abc123.json", r.URL.Path)
+		_, _ = w.Write([]byte(`{"current_material": "git-1", "levels": [
+			{"nodes": [{"id": "git-1", "node_type": "GIT", "parents": [], "dependents": ["build"]}]},
+			{"nodes": [{"id": "build", "node_type": "PIPELINE", "parents": ["git-1"], "dependents": []}]}
+		]}`))
+	}))
+	defer ts.Close()
+
+	url, _ := url.Parse(ts.URL)
+	m, err := GetMaterialValueStreamMap(context.TODO(), client.NewClient(url), "git-1", "abc123")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"build"}, ids(m.DownstreamPipelines(m.CurrentMaterial)))
+}
@@ -1,6 +1,8 @@
 package version
 
 import (
+	"context"
+
 	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
 	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
@@ -10,6 +12,6 @@ const (
 	endpoint = "/api/version"
 )
 
-func GetVersion(c *client.Client) (*types.Version, error) {
-	return client.Get[types.Version](c, endpoint, constants.AcceptV1, "version")
+func GetVersion(ctx context.Context, c *client.Client) (*types.Version, error) {
+	return client.Get[types.Version](ctx, c, endpoint, constants.AcceptV1, "version")
 }
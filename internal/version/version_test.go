@@ -126,7 +126,7 @@ func TestGetVersion(t *testing.T) {
 			t.Parallel()
 			defer tt.args.ts.Close()
 			url, _ := url.Parse(tt.args.ts.URL)
-			got, err := GetVersion(client.NewClient(context.TODO(), url))
+			got, err := GetVersion(context.TODO(), client.NewClient(url))
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -148,10 +148,10 @@ func TestRequestFailureWithTimeout(t *testing.T) {
 	defer ts.Close()
 
 	url, _ := url.Parse(ts.URL)
-	c := client.NewClient(context.TODO(), url)
+	c := client.NewClient(url)
 	c.HttpClient.Timeout = 1 * time.Second // Set the client timeout to 1 second
 
-	_, err := GetVersion(c)
+	_, err := GetVersion(context.TODO(), c)
 	require.Error(t, err)
 	// The error should be related to the client timeout
 	assert.Contains(t, err.Error(), "Client.Timeout")
@@ -170,7 +170,7 @@ func TestNon2xxSuccess(t *testing.T) {
 	defer ts.Close()
 
 	url, _ := url.Parse(ts.URL)
-	_, err := GetVersion(client.NewClient(context.TODO(), url))
+	_, err := GetVersion(context.TODO(), client.NewClient(url))
 	require.Error(t, err)
 	// The error should contain the 3xx status code
 	assert.Contains(t, err.Error(), "301 Moved Permanently")
@@ -178,7 +178,7 @@ func TestNon2xxSuccess(t *testing.T) {
 
 func TestClientErrorMalformedURL(t *testing.T) {
 	t.Parallel()
-	_, err := GetVersion(client.NewClient(context.TODO(), &url.URL{})) // Pass an empty url.URL object
+	_, err := GetVersion(context.TODO(), client.NewClient(&url.URL{})) // Pass an empty url.URL object
 	require.Error(t, err)
 	// The error message should indicate the URL is invalid
 	assert.Contains(t, err.Error(), "unsupported protocol scheme")
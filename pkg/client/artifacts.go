@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/artifacts"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+var (
+	// ErrChecksumMismatch is returned when a downloaded artifact does not match its MD5 checksum.
+	ErrChecksumMismatch = artifacts.ErrChecksumMismatch
+	// ErrChecksumMissing is returned when GoCD recorded no checksum for an artifact to verify.
+	ErrChecksumMissing = artifacts.ErrChecksumMissing
+)
+
+// ArtifactsAPI transfers artifacts without buffering them, note that the client timeout, 1
+// minute unless set with WithTimeout or WithHTTPClient, bounds each whole transfer.
+type ArtifactsAPI interface {
+	GetArtifacts(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) ([]types.ArtifactNode, error)
+	DownloadArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error)
+	DownloadArtifactDirectory(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error)
+	UploadArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest, name string, r io.Reader) (string, error)
+	UploadArtifactDirectory(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest string, dir fs.FS) (string, error)
+	AppendArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, r io.Reader) (string, error)
+}
+
+// GetArtifacts returns the artifact tree of the job.
+func (c *Client) GetArtifacts(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) ([]types.ArtifactNode, error) {
+	return artifacts.GetArtifacts(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job)
+}
+
+// DownloadArtifact writes the file at path, relative to the job artifacts, to w and returns
+// the number of bytes written, opts may be nil.
+func (c *Client) DownloadArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error) {
+	return artifacts.DownloadArtifact(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job, path, w, opts)
+}
+
+// DownloadArtifactDirectory writes the directory at path as a zip archive to w, waiting for
+// GoCD to build it, opts may be nil.
+func (c *Client) DownloadArtifactDirectory(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error) {
+	return artifacts.DownloadArtifactDirectory(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job, path, w, opts)
+}
+
+// UploadArtifact stores r as the file dest/name of the job artifacts.
+func (c *Client) UploadArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest, name string, r io.Reader) (string, error) {
+	return artifacts.UploadArtifact(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job, dest, name, r)
+}
+
+// UploadArtifactDirectory stores every file of dir under dest, e.g. os.DirFS("dist").
+func (c *Client) UploadArtifactDirectory(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest string, dir fs.FS) (string, error) {
+	return artifacts.UploadArtifactDirectory(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job, dest, dir)
+}
+
+func (c *Client) AppendArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, r io.Reader) (string, error) {
+	return artifacts.AppendArtifact(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job, path, r)
+}
@@ -1,10 +1,16 @@
 package client
 
 import (
+	"context"
+
 	"github.com/AlinScreciu/gocd-go-api-client/internal/authentication"
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
 )
 
-func (c *Client) GetCurrentUser() (*types.CurrentUser, error) {
-	return authentication.GetCurrentUser(c.client)
+type AuthenticationAPI interface {
+	GetCurrentUser(ctx context.Context) (*types.CurrentUser, error)
+}
+
+func (c *Client) GetCurrentUser(ctx context.Context) (*types.CurrentUser, error) {
+	return authentication.GetCurrentUser(ctx, c.client)
 }
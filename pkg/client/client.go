@@ -1,50 +1,72 @@
 package client
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 
 	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
-	"github.com/AlinScreciu/gocd-go-api-client/internal/logging"
-	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
 )
 
-var logger *logging.Logger
-
-func init() {
-	logger = logging.NewLoggerWithModule("client")
-}
-
+// GoCDClient is implemented by Client, depend on it or on one of the per-area
+// interfaces it embeds to be able to swap in a clienttest.Fake.
 type GoCDClient interface {
-	GetVersion() (*types.Version, error)
+	VersionAPI
+	AuthenticationAPI
+	PackagesAPI
+	PipelineConfigsAPI
+	PipelineExportAPI
+	PipelineGroupsAPI
+	TemplatesAPI
+	PipelineOperationsAPI
+	PipelineInstancesAPI
+	PipelineStatusAPI
+	StagesAPI
+	DashboardAPI
+	ValueStreamMapAPI
+	JobsAPI
+	ArtifactsAPI
 }
 
+var _ GoCDClient = (*Client)(nil)
+
 type Client struct {
 	client *client.Client
 }
 
+// Deprecated: pass WithDebug to NewClient instead.
 func (c *Client) SetDebug() {
 	c.client.Debug = true
 }
 
+// Deprecated: pass WithBasicAuth to NewClient instead.
 func (c *Client) SetBasicAuth(user, password string) {
 	c.client.SetBasicAuth(user, password)
 }
 
+// Deprecated: pass WithAccessToken to NewClient instead.
 func (c *Client) SetAccessToken(token string) {
 	c.client.SetAccessToken(token)
 }
 
-func NewClient(ctx context.Context, serverUrl string) (*Client, error) {
+func NewClient(serverUrl string, opts ...Option) (*Client, error) {
 	url, err := url.Parse(serverUrl)
 	if err != nil {
-		logger.Errorf("failed to parse '%s' to url: '%s'", url, err)
-
 		return nil, fmt.Errorf("failed to parse '%s' to url: '%w'", serverUrl, err)
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c := client.NewClient(url)
+
+	err = o.apply(c)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client options: '%w'", err)
+	}
+
 	return &Client{
-		client: client.NewClient(ctx, url),
+		client: c,
 	}, nil
 }
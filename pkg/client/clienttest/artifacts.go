@@ -0,0 +1,64 @@
+package clienttest
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetArtifacts(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) ([]types.ArtifactNode, error) {
+	f.record("GetArtifacts", pipeline, pipelineCounter, stage, stageCounter, job)
+	if f.GetArtifactsFunc == nil {
+		return nil, notConfigured("GetArtifacts")
+	}
+
+	return f.GetArtifactsFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job)
+}
+
+func (f *Fake) DownloadArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error) {
+	f.record("DownloadArtifact", pipeline, pipelineCounter, stage, stageCounter, job, path, opts)
+	if f.DownloadArtifactFunc == nil {
+		return 0, notConfigured("DownloadArtifact")
+	}
+
+	return f.DownloadArtifactFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job, path, w, opts)
+}
+
+func (f *Fake) DownloadArtifactDirectory(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error) {
+	f.record("DownloadArtifactDirectory", pipeline, pipelineCounter, stage, stageCounter, job, path, opts)
+	if f.DownloadArtifactDirectoryFunc == nil {
+		return 0, notConfigured("DownloadArtifactDirectory")
+	}
+
+	return f.DownloadArtifactDirectoryFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job, path, w, opts)
+}
+
+// UploadArtifact records the reader itself, UploadArtifactFunc decides whether to consume it.
+func (f *Fake) UploadArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest, name string, r io.Reader) (string, error) {
+	f.record("UploadArtifact", pipeline, pipelineCounter, stage, stageCounter, job, dest, name, r)
+	if f.UploadArtifactFunc == nil {
+		return "", notConfigured("UploadArtifact")
+	}
+
+	return f.UploadArtifactFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job, dest, name, r)
+}
+
+func (f *Fake) UploadArtifactDirectory(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest string, dir fs.FS) (string, error) {
+	f.record("UploadArtifactDirectory", pipeline, pipelineCounter, stage, stageCounter, job, dest, dir)
+	if f.UploadArtifactDirectoryFunc == nil {
+		return "", notConfigured("UploadArtifactDirectory")
+	}
+
+	return f.UploadArtifactDirectoryFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job, dest, dir)
+}
+
+func (f *Fake) AppendArtifact(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, r io.Reader) (string, error) {
+	f.record("AppendArtifact", pipeline, pipelineCounter, stage, stageCounter, job, path, r)
+	if f.AppendArtifactFunc == nil {
+		return "", notConfigured("AppendArtifact")
+	}
+
+	return f.AppendArtifactFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job, path, r)
+}
@@ -0,0 +1,16 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetCurrentUser(ctx context.Context) (*types.CurrentUser, error) {
+	f.record("GetCurrentUser")
+	if f.GetCurrentUserFunc == nil {
+		return nil, notConfigured("GetCurrentUser")
+	}
+
+	return f.GetCurrentUserFunc(ctx)
+}
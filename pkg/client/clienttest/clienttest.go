@@ -0,0 +1,170 @@
+// Package clienttest provides a programmable fake of client.GoCDClient for unit tests.
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"sync"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+// ErrNotConfigured is returned by every method whose *Func field is not set.
+var ErrNotConfigured = errors.New("clienttest: no response configured")
+
+// Call records a single method invocation, Args excludes the context.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Fake implements client.GoCDClient, every method records its call and then delegates
+// to the matching *Func field. The zero value is ready to use; set the *Func fields
+// before sharing the fake between goroutines.
+type Fake struct {
+	mu    sync.Mutex
+	calls []Call
+
+	// VersionAPI
+	GetVersionFunc func(ctx context.Context) (*types.Version, error)
+
+	// AuthenticationAPI
+	GetCurrentUserFunc func(ctx context.Context) (*types.CurrentUser, error)
+
+	// PackagesAPI
+	GetAllPackagesFunc     func(ctx context.Context) (*types.AllPackages, error)
+	CreatePackageFunc      func(ctx context.Context, pkg *types.Package) (*types.Package, error)
+	GetPackageFunc         func(ctx context.Context, packageId string) (*types.Package, error)
+	GetPackageWithETagFunc func(ctx context.Context, packageId string) (*types.Package, string, error)
+	UpdatePackageFunc      func(ctx context.Context, pkg *types.Package, eTag string) (*types.Package, error)
+	DeletePackageFunc      func(ctx context.Context, packageId string) (string, error)
+	ModifyPackageFunc      func(ctx context.Context, packageId string, mutate func(*types.Package) error) (*types.Package, error)
+
+	// PipelineConfigsAPI
+	GetPipelineConfigFunc         func(ctx context.Context, name string) (*types.PipelineConfig, error)
+	GetPipelineConfigWithETagFunc func(ctx context.Context, name string) (*types.PipelineConfig, string, error)
+	CreatePipelineConfigFunc      func(ctx context.Context, group string, pipeline *types.PipelineConfig) (*types.PipelineConfig, error)
+	UpdatePipelineConfigFunc      func(ctx context.Context, pipeline *types.PipelineConfig, eTag string) (*types.PipelineConfig, error)
+	DeletePipelineConfigFunc      func(ctx context.Context, name string) (string, error)
+	ModifyPipelineConfigFunc      func(ctx context.Context, name string, mutate func(*types.PipelineConfig) error) (*types.PipelineConfig, error)
+
+	// PipelineExportAPI
+	ExportPipelineFunc      func(ctx context.Context, pipeline, pluginId string) (*types.PipelineExport, error)
+	ExportPipelineGroupFunc func(ctx context.Context, group, pluginId, dir string) ([]string, error)
+
+	// PipelineGroupsAPI
+	GetAllPipelineGroupsFunc     func(ctx context.Context) (*types.AllPipelineGroups, error)
+	GetPipelineGroupFunc         func(ctx context.Context, name string) (*types.PipelineGroup, error)
+	GetPipelineGroupWithETagFunc func(ctx context.Context, name string) (*types.PipelineGroup, string, error)
+	CreatePipelineGroupFunc      func(ctx context.Context, group *types.PipelineGroup) (*types.PipelineGroup, error)
+	UpdatePipelineGroupFunc      func(ctx context.Context, group *types.PipelineGroup, eTag string) (*types.PipelineGroup, error)
+	DeletePipelineGroupFunc      func(ctx context.Context, name string) (string, error)
+	ModifyPipelineGroupFunc      func(ctx context.Context, name string, mutate func(*types.PipelineGroup) error) (*types.PipelineGroup, error)
+	GrantPipelineGroupRoleFunc   func(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error)
+	RevokePipelineGroupRoleFunc  func(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error)
+
+	// TemplatesAPI
+	GetAllTemplatesFunc                  func(ctx context.Context) (*types.AllTemplates, error)
+	GetTemplateFunc                      func(ctx context.Context, name string) (*types.TemplateConfig, error)
+	GetTemplateWithETagFunc              func(ctx context.Context, name string) (*types.TemplateConfig, string, error)
+	CreateTemplateFunc                   func(ctx context.Context, template *types.TemplateConfig) (*types.TemplateConfig, error)
+	UpdateTemplateFunc                   func(ctx context.Context, template *types.TemplateConfig, eTag string) (*types.TemplateConfig, error)
+	DeleteTemplateFunc                   func(ctx context.Context, name string) (string, error)
+	ModifyTemplateFunc                   func(ctx context.Context, name string, mutate func(*types.TemplateConfig) error) (*types.TemplateConfig, error)
+	GetTemplateAuthorizationFunc         func(ctx context.Context, name string) (*types.TemplateAuthorization, error)
+	GetTemplateAuthorizationWithETagFunc func(ctx context.Context, name string) (*types.TemplateAuthorization, string, error)
+	UpdateTemplateAuthorizationFunc      func(ctx context.Context, name string, authorization *types.TemplateAuthorization, eTag string) (*types.TemplateAuthorization, error)
+	ModifyTemplateAuthorizationFunc      func(ctx context.Context, name string, mutate func(*types.TemplateAuthorization) error) (*types.TemplateAuthorization, error)
+
+	// PipelineOperationsAPI
+	PausePipelineFunc    func(ctx context.Context, name, cause string) (string, error)
+	UnpausePipelineFunc  func(ctx context.Context, name string) (string, error)
+	UnlockPipelineFunc   func(ctx context.Context, name string) (string, error)
+	SchedulePipelineFunc func(ctx context.Context, name string, opts *types.ScheduleOptions) (string, error)
+	RunPipelineFunc      func(ctx context.Context, name string, opts *types.RunPipelineOptions) (*types.PipelineInstance, error)
+
+	// PipelineInstancesAPI
+	GetPipelineHistoryFunc       func(ctx context.Context, name string, opts *types.PageOptions) (*types.PipelineHistory, error)
+	PipelineHistoryFunc          func(name string, opts *types.PageOptions) *client.Iterator[types.PipelineInstance]
+	GetPipelineInstanceFunc      func(ctx context.Context, name string, counter int) (*types.PipelineInstance, error)
+	ComparePipelineInstancesFunc func(ctx context.Context, name string, from, to int) (*types.PipelineComparison, error)
+
+	// PipelineStatusAPI
+	GetPipelineStatusFunc func(ctx context.Context, name string) (*types.PipelineStatus, error)
+
+	// StagesAPI
+	RunStageFunc         func(ctx context.Context, pipeline string, pipelineCounter int, stage string) (string, error)
+	CancelStageFunc      func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error)
+	RunFailedJobsFunc    func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error)
+	RunSelectedJobsFunc  func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, jobs []string) (string, error)
+	GetStageInstanceFunc func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (*types.StageInstance, error)
+	GetStageHistoryFunc  func(ctx context.Context, pipeline, stage string, opts *types.PageOptions) (*types.StageHistory, error)
+	StageHistoryFunc     func(pipeline, stage string, opts *types.PageOptions) *client.Iterator[types.StageInstance]
+
+	// DashboardAPI
+	GetDashboardFunc func(ctx context.Context) (*types.Dashboard, error)
+
+	// ValueStreamMapAPI
+	GetPipelineValueStreamMapFunc func(ctx context.Context, pipeline string, counter int) (*types.ValueStreamMap, error)
+	GetMaterialValueStreamMapFunc func(ctx context.Context, fingerprint, revision string) (*types.ValueStreamMap, error)
+
+	// JobsAPI
+	GetJobInstanceFunc   func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) (*types.JobInstance, error)
+	GetJobHistoryFunc    func(ctx context.Context, pipeline, stage, job string, opts *types.PageOptions) (*types.JobHistory, error)
+	JobHistoryFunc       func(pipeline, stage, job string, opts *types.PageOptions) *client.Iterator[types.JobInstance]
+	FollowConsoleLogFunc func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string, opts *types.ConsoleLogOptions) io.ReadCloser
+
+	// ArtifactsAPI
+	GetArtifactsFunc              func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) ([]types.ArtifactNode, error)
+	DownloadArtifactFunc          func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error)
+	DownloadArtifactDirectoryFunc func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, w io.Writer, opts *types.ArtifactDownloadOptions) (int64, error)
+	UploadArtifactFunc            func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest, name string, r io.Reader) (string, error)
+	UploadArtifactDirectoryFunc   func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, dest string, dir fs.FS) (string, error)
+	AppendArtifactFunc            func(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job, path string, r io.Reader) (string, error)
+}
+
+var _ client.GoCDClient = (*Fake)(nil)
+
+func (f *Fake) record(method string, args ...any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every recorded call, in order.
+func (f *Fake) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls of a single method, e.g. "GetPackage".
+func (f *Fake) CallsTo(method string) []Call {
+	var calls []Call
+
+	for _, call := range f.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the recorded calls, the *Func fields are kept.
+func (f *Fake) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = nil
+}
+
+func notConfigured(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotConfigured, method)
+}
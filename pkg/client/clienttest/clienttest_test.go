@@ -0,0 +1,56 @@
+package clienttest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake(t *testing.T) {
+	t.Parallel()
+
+	fake := &Fake{
+		GetPackageFunc: func(ctx context.Context, packageId string) (*types.Package, error) {
+			if packageId == "missing" {
+				return nil, client.ErrNotFound
+			}
+
+			return &types.Package{Id: packageId, Name: "pkg"}, nil
+		},
+		UpdatePackageFunc: func(ctx context.Context, pkg *types.Package, eTag string) (*types.Package, error) {
+			return pkg, nil
+		},
+	}
+
+	var api client.PackagesAPI = fake
+
+	pkg, err := api.GetPackage(context.TODO(), "pkg-1")
+	require.NoError(t, err)
+	assert.Equal(t, "pkg-1", pkg.Id)
+
+	_, err = api.GetPackage(context.TODO(), "missing")
+	assert.True(t, client.IsNotFound(err))
+
+	modified, err := api.ModifyPackage(context.TODO(), "pkg-1", func(p *types.Package) error {
+		p.AutoUpdate = true
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, modified.AutoUpdate)
+
+	_, err = api.DeletePackage(context.TODO(), "pkg-1")
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	assert.Equal(t, []Call{
+		{Method: "GetPackage", Args: []any{"missing"}},
+	}, fake.CallsTo("GetPackage")[1:])
+	assert.Len(t, fake.Calls(), 4)
+
+	fake.Reset()
+	assert.Empty(t, fake.Calls())
+}
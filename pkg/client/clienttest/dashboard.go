@@ -0,0 +1,16 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetDashboard(ctx context.Context) (*types.Dashboard, error) {
+	f.record("GetDashboard")
+	if f.GetDashboardFunc == nil {
+		return nil, notConfigured("GetDashboard")
+	}
+
+	return f.GetDashboardFunc(ctx)
+}
@@ -0,0 +1,25 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) ExportPipeline(ctx context.Context, pipeline, pluginId string) (*types.PipelineExport, error) {
+	f.record("ExportPipeline", pipeline, pluginId)
+	if f.ExportPipelineFunc == nil {
+		return nil, notConfigured("ExportPipeline")
+	}
+
+	return f.ExportPipelineFunc(ctx, pipeline, pluginId)
+}
+
+func (f *Fake) ExportPipelineGroup(ctx context.Context, group, pluginId, dir string) ([]string, error) {
+	f.record("ExportPipelineGroup", group, pluginId, dir)
+	if f.ExportPipelineGroupFunc == nil {
+		return nil, notConfigured("ExportPipelineGroup")
+	}
+
+	return f.ExportPipelineGroupFunc(ctx, group, pluginId, dir)
+}
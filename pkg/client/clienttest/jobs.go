@@ -0,0 +1,62 @@
+package clienttest
+
+import (
+	"context"
+	"io"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetJobInstance(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) (*types.JobInstance, error) {
+	f.record("GetJobInstance", pipeline, pipelineCounter, stage, stageCounter, job)
+	if f.GetJobInstanceFunc == nil {
+		return nil, notConfigured("GetJobInstance")
+	}
+
+	return f.GetJobInstanceFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job)
+}
+
+func (f *Fake) GetJobHistory(ctx context.Context, pipeline, stage, job string, opts *types.PageOptions) (*types.JobHistory, error) {
+	f.record("GetJobHistory", pipeline, stage, job, opts)
+	if f.GetJobHistoryFunc == nil {
+		return nil, notConfigured("GetJobHistory")
+	}
+
+	return f.GetJobHistoryFunc(ctx, pipeline, stage, job, opts)
+}
+
+// JobHistory falls back to iterating over a single GetJobHistoryFunc page when
+// JobHistoryFunc is not set.
+func (f *Fake) JobHistory(pipeline, stage, job string, opts *types.PageOptions) *client.Iterator[types.JobInstance] {
+	f.record("JobHistory", pipeline, stage, job, opts)
+
+	if f.JobHistoryFunc != nil {
+		return f.JobHistoryFunc(pipeline, stage, job, opts)
+	}
+
+	if f.GetJobHistoryFunc == nil {
+		return client.NewSliceIterator[types.JobInstance](nil, notConfigured("JobHistory"))
+	}
+
+	history, err := f.GetJobHistoryFunc(context.Background(), pipeline, stage, job, opts)
+	if err != nil {
+		return client.NewSliceIterator[types.JobInstance](nil, err)
+	}
+
+	return client.NewSliceIterator(history.Jobs, nil)
+}
+
+// FollowConsoleLog returns a reader failing with ErrNotConfigured when FollowConsoleLogFunc
+// is not set, a canned log can be returned with io.NopCloser(strings.NewReader(...)).
+func (f *Fake) FollowConsoleLog(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string, opts *types.ConsoleLogOptions) io.ReadCloser {
+	f.record("FollowConsoleLog", pipeline, pipelineCounter, stage, stageCounter, job, opts)
+	if f.FollowConsoleLogFunc == nil {
+		pr, pw := io.Pipe()
+		pw.CloseWithError(notConfigured("FollowConsoleLog"))
+
+		return pr
+	}
+
+	return f.FollowConsoleLogFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, job, opts)
+}
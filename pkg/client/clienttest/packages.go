@@ -0,0 +1,85 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetAllPackages(ctx context.Context) (*types.AllPackages, error) {
+	f.record("GetAllPackages")
+	if f.GetAllPackagesFunc == nil {
+		return nil, notConfigured("GetAllPackages")
+	}
+
+	return f.GetAllPackagesFunc(ctx)
+}
+
+func (f *Fake) CreatePackage(ctx context.Context, pkg *types.Package) (*types.Package, error) {
+	f.record("CreatePackage", pkg)
+	if f.CreatePackageFunc == nil {
+		return nil, notConfigured("CreatePackage")
+	}
+
+	return f.CreatePackageFunc(ctx, pkg)
+}
+
+func (f *Fake) GetPackage(ctx context.Context, packageId string) (*types.Package, error) {
+	f.record("GetPackage", packageId)
+	if f.GetPackageFunc == nil {
+		return nil, notConfigured("GetPackage")
+	}
+
+	return f.GetPackageFunc(ctx, packageId)
+}
+
+func (f *Fake) GetPackageWithETag(ctx context.Context, packageId string) (*types.Package, string, error) {
+	f.record("GetPackageWithETag", packageId)
+	if f.GetPackageWithETagFunc == nil {
+		return nil, "", notConfigured("GetPackageWithETag")
+	}
+
+	return f.GetPackageWithETagFunc(ctx, packageId)
+}
+
+func (f *Fake) UpdatePackage(ctx context.Context, pkg *types.Package, eTag string) (*types.Package, error) {
+	f.record("UpdatePackage", pkg, eTag)
+	if f.UpdatePackageFunc == nil {
+		return nil, notConfigured("UpdatePackage")
+	}
+
+	return f.UpdatePackageFunc(ctx, pkg, eTag)
+}
+
+func (f *Fake) DeletePackage(ctx context.Context, packageId string) (string, error) {
+	f.record("DeletePackage", packageId)
+	if f.DeletePackageFunc == nil {
+		return "", notConfigured("DeletePackage")
+	}
+
+	return f.DeletePackageFunc(ctx, packageId)
+}
+
+// ModifyPackage falls back to GetPackageFunc and UpdatePackageFunc when ModifyPackageFunc is not set.
+func (f *Fake) ModifyPackage(ctx context.Context, packageId string, mutate func(*types.Package) error) (*types.Package, error) {
+	f.record("ModifyPackage", packageId)
+	if f.ModifyPackageFunc != nil {
+		return f.ModifyPackageFunc(ctx, packageId, mutate)
+	}
+
+	if f.GetPackageFunc == nil || f.UpdatePackageFunc == nil {
+		return nil, notConfigured("ModifyPackage")
+	}
+
+	pkg, err := f.GetPackageFunc(ctx, packageId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = mutate(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.UpdatePackageFunc(ctx, pkg, "")
+}
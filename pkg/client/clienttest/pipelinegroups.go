@@ -0,0 +1,88 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetAllPipelineGroups(ctx context.Context) (*types.AllPipelineGroups, error) {
+	f.record("GetAllPipelineGroups")
+	if f.GetAllPipelineGroupsFunc == nil {
+		return nil, notConfigured("GetAllPipelineGroups")
+	}
+
+	return f.GetAllPipelineGroupsFunc(ctx)
+}
+
+func (f *Fake) GetPipelineGroup(ctx context.Context, name string) (*types.PipelineGroup, error) {
+	f.record("GetPipelineGroup", name)
+	if f.GetPipelineGroupFunc == nil {
+		return nil, notConfigured("GetPipelineGroup")
+	}
+
+	return f.GetPipelineGroupFunc(ctx, name)
+}
+
+func (f *Fake) GetPipelineGroupWithETag(ctx context.Context, name string) (*types.PipelineGroup, string, error) {
+	f.record("GetPipelineGroupWithETag", name)
+	if f.GetPipelineGroupWithETagFunc == nil {
+		return nil, "", notConfigured("GetPipelineGroupWithETag")
+	}
+
+	return f.GetPipelineGroupWithETagFunc(ctx, name)
+}
+
+func (f *Fake) CreatePipelineGroup(ctx context.Context, group *types.PipelineGroup) (*types.PipelineGroup, error) {
+	f.record("CreatePipelineGroup", group)
+	if f.CreatePipelineGroupFunc == nil {
+		return nil, notConfigured("CreatePipelineGroup")
+	}
+
+	return f.CreatePipelineGroupFunc(ctx, group)
+}
+
+func (f *Fake) UpdatePipelineGroup(ctx context.Context, group *types.PipelineGroup, eTag string) (*types.PipelineGroup, error) {
+	f.record("UpdatePipelineGroup", group, eTag)
+	if f.UpdatePipelineGroupFunc == nil {
+		return nil, notConfigured("UpdatePipelineGroup")
+	}
+
+	return f.UpdatePipelineGroupFunc(ctx, group, eTag)
+}
+
+func (f *Fake) DeletePipelineGroup(ctx context.Context, name string) (string, error) {
+	f.record("DeletePipelineGroup", name)
+	if f.DeletePipelineGroupFunc == nil {
+		return "", notConfigured("DeletePipelineGroup")
+	}
+
+	return f.DeletePipelineGroupFunc(ctx, name)
+}
+
+func (f *Fake) ModifyPipelineGroup(ctx context.Context, name string, mutate func(*types.PipelineGroup) error) (*types.PipelineGroup, error) {
+	f.record("ModifyPipelineGroup", name, mutate)
+	if f.ModifyPipelineGroupFunc == nil {
+		return nil, notConfigured("ModifyPipelineGroup")
+	}
+
+	return f.ModifyPipelineGroupFunc(ctx, name, mutate)
+}
+
+func (f *Fake) GrantPipelineGroupRole(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error) {
+	f.record("GrantPipelineGroupRole", name, permission, role)
+	if f.GrantPipelineGroupRoleFunc == nil {
+		return nil, notConfigured("GrantPipelineGroupRole")
+	}
+
+	return f.GrantPipelineGroupRoleFunc(ctx, name, permission, role)
+}
+
+func (f *Fake) RevokePipelineGroupRole(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error) {
+	f.record("RevokePipelineGroupRole", name, permission, role)
+	if f.RevokePipelineGroupRoleFunc == nil {
+		return nil, notConfigured("RevokePipelineGroupRole")
+	}
+
+	return f.RevokePipelineGroupRoleFunc(ctx, name, permission, role)
+}
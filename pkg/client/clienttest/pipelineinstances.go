@@ -0,0 +1,56 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetPipelineHistory(ctx context.Context, name string, opts *types.PageOptions) (*types.PipelineHistory, error) {
+	f.record("GetPipelineHistory", name, opts)
+	if f.GetPipelineHistoryFunc == nil {
+		return nil, notConfigured("GetPipelineHistory")
+	}
+
+	return f.GetPipelineHistoryFunc(ctx, name, opts)
+}
+
+// PipelineHistory falls back to iterating over a single GetPipelineHistoryFunc page
+// when PipelineHistoryFunc is not set.
+func (f *Fake) PipelineHistory(name string, opts *types.PageOptions) *client.Iterator[types.PipelineInstance] {
+	f.record("PipelineHistory", name, opts)
+
+	if f.PipelineHistoryFunc != nil {
+		return f.PipelineHistoryFunc(name, opts)
+	}
+
+	if f.GetPipelineHistoryFunc == nil {
+		return client.NewSliceIterator[types.PipelineInstance](nil, notConfigured("PipelineHistory"))
+	}
+
+	history, err := f.GetPipelineHistoryFunc(context.Background(), name, opts)
+	if err != nil {
+		return client.NewSliceIterator[types.PipelineInstance](nil, err)
+	}
+
+	return client.NewSliceIterator(history.Pipelines, nil)
+}
+
+func (f *Fake) GetPipelineInstance(ctx context.Context, name string, counter int) (*types.PipelineInstance, error) {
+	f.record("GetPipelineInstance", name, counter)
+	if f.GetPipelineInstanceFunc == nil {
+		return nil, notConfigured("GetPipelineInstance")
+	}
+
+	return f.GetPipelineInstanceFunc(ctx, name, counter)
+}
+
+func (f *Fake) ComparePipelineInstances(ctx context.Context, name string, from, to int) (*types.PipelineComparison, error) {
+	f.record("ComparePipelineInstances", name, from, to)
+	if f.ComparePipelineInstancesFunc == nil {
+		return nil, notConfigured("ComparePipelineInstances")
+	}
+
+	return f.ComparePipelineInstancesFunc(ctx, name, from, to)
+}
@@ -0,0 +1,52 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) PausePipeline(ctx context.Context, name, cause string) (string, error) {
+	f.record("PausePipeline", name, cause)
+	if f.PausePipelineFunc == nil {
+		return "", notConfigured("PausePipeline")
+	}
+
+	return f.PausePipelineFunc(ctx, name, cause)
+}
+
+func (f *Fake) UnpausePipeline(ctx context.Context, name string) (string, error) {
+	f.record("UnpausePipeline", name)
+	if f.UnpausePipelineFunc == nil {
+		return "", notConfigured("UnpausePipeline")
+	}
+
+	return f.UnpausePipelineFunc(ctx, name)
+}
+
+func (f *Fake) UnlockPipeline(ctx context.Context, name string) (string, error) {
+	f.record("UnlockPipeline", name)
+	if f.UnlockPipelineFunc == nil {
+		return "", notConfigured("UnlockPipeline")
+	}
+
+	return f.UnlockPipelineFunc(ctx, name)
+}
+
+func (f *Fake) SchedulePipeline(ctx context.Context, name string, opts *types.ScheduleOptions) (string, error) {
+	f.record("SchedulePipeline", name, opts)
+	if f.SchedulePipelineFunc == nil {
+		return "", notConfigured("SchedulePipeline")
+	}
+
+	return f.SchedulePipelineFunc(ctx, name, opts)
+}
+
+func (f *Fake) RunPipeline(ctx context.Context, name string, opts *types.RunPipelineOptions) (*types.PipelineInstance, error) {
+	f.record("RunPipeline", name, opts)
+	if f.RunPipelineFunc == nil {
+		return nil, notConfigured("RunPipeline")
+	}
+
+	return f.RunPipelineFunc(ctx, name, opts)
+}
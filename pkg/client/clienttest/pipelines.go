@@ -0,0 +1,77 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetPipelineConfig(ctx context.Context, name string) (*types.PipelineConfig, error) {
+	f.record("GetPipelineConfig", name)
+	if f.GetPipelineConfigFunc == nil {
+		return nil, notConfigured("GetPipelineConfig")
+	}
+
+	return f.GetPipelineConfigFunc(ctx, name)
+}
+
+func (f *Fake) GetPipelineConfigWithETag(ctx context.Context, name string) (*types.PipelineConfig, string, error) {
+	f.record("GetPipelineConfigWithETag", name)
+	if f.GetPipelineConfigWithETagFunc == nil {
+		return nil, "", notConfigured("GetPipelineConfigWithETag")
+	}
+
+	return f.GetPipelineConfigWithETagFunc(ctx, name)
+}
+
+func (f *Fake) CreatePipelineConfig(ctx context.Context, group string, pipeline *types.PipelineConfig) (*types.PipelineConfig, error) {
+	f.record("CreatePipelineConfig", group, pipeline)
+	if f.CreatePipelineConfigFunc == nil {
+		return nil, notConfigured("CreatePipelineConfig")
+	}
+
+	return f.CreatePipelineConfigFunc(ctx, group, pipeline)
+}
+
+func (f *Fake) UpdatePipelineConfig(ctx context.Context, pipeline *types.PipelineConfig, eTag string) (*types.PipelineConfig, error) {
+	f.record("UpdatePipelineConfig", pipeline, eTag)
+	if f.UpdatePipelineConfigFunc == nil {
+		return nil, notConfigured("UpdatePipelineConfig")
+	}
+
+	return f.UpdatePipelineConfigFunc(ctx, pipeline, eTag)
+}
+
+func (f *Fake) DeletePipelineConfig(ctx context.Context, name string) (string, error) {
+	f.record("DeletePipelineConfig", name)
+	if f.DeletePipelineConfigFunc == nil {
+		return "", notConfigured("DeletePipelineConfig")
+	}
+
+	return f.DeletePipelineConfigFunc(ctx, name)
+}
+
+// ModifyPipelineConfig falls back to GetPipelineConfigFunc and UpdatePipelineConfigFunc
+// when ModifyPipelineConfigFunc is not set.
+func (f *Fake) ModifyPipelineConfig(ctx context.Context, name string, mutate func(*types.PipelineConfig) error) (*types.PipelineConfig, error) {
+	f.record("ModifyPipelineConfig", name)
+	if f.ModifyPipelineConfigFunc != nil {
+		return f.ModifyPipelineConfigFunc(ctx, name, mutate)
+	}
+
+	if f.GetPipelineConfigFunc == nil || f.UpdatePipelineConfigFunc == nil {
+		return nil, notConfigured("ModifyPipelineConfig")
+	}
+
+	pipeline, err := f.GetPipelineConfigFunc(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = mutate(pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.UpdatePipelineConfigFunc(ctx, pipeline, "")
+}
@@ -0,0 +1,16 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetPipelineStatus(ctx context.Context, name string) (*types.PipelineStatus, error) {
+	f.record("GetPipelineStatus", name)
+	if f.GetPipelineStatusFunc == nil {
+		return nil, notConfigured("GetPipelineStatus")
+	}
+
+	return f.GetPipelineStatusFunc(ctx, name)
+}
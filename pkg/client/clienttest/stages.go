@@ -0,0 +1,83 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) RunStage(ctx context.Context, pipeline string, pipelineCounter int, stage string) (string, error) {
+	f.record("RunStage", pipeline, pipelineCounter, stage)
+	if f.RunStageFunc == nil {
+		return "", notConfigured("RunStage")
+	}
+
+	return f.RunStageFunc(ctx, pipeline, pipelineCounter, stage)
+}
+
+func (f *Fake) CancelStage(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error) {
+	f.record("CancelStage", pipeline, pipelineCounter, stage, stageCounter)
+	if f.CancelStageFunc == nil {
+		return "", notConfigured("CancelStage")
+	}
+
+	return f.CancelStageFunc(ctx, pipeline, pipelineCounter, stage, stageCounter)
+}
+
+func (f *Fake) RunFailedJobs(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error) {
+	f.record("RunFailedJobs", pipeline, pipelineCounter, stage, stageCounter)
+	if f.RunFailedJobsFunc == nil {
+		return "", notConfigured("RunFailedJobs")
+	}
+
+	return f.RunFailedJobsFunc(ctx, pipeline, pipelineCounter, stage, stageCounter)
+}
+
+func (f *Fake) RunSelectedJobs(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, jobs []string) (string, error) {
+	f.record("RunSelectedJobs", pipeline, pipelineCounter, stage, stageCounter, jobs)
+	if f.RunSelectedJobsFunc == nil {
+		return "", notConfigured("RunSelectedJobs")
+	}
+
+	return f.RunSelectedJobsFunc(ctx, pipeline, pipelineCounter, stage, stageCounter, jobs)
+}
+
+func (f *Fake) GetStageInstance(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (*types.StageInstance, error) {
+	f.record("GetStageInstance", pipeline, pipelineCounter, stage, stageCounter)
+	if f.GetStageInstanceFunc == nil {
+		return nil, notConfigured("GetStageInstance")
+	}
+
+	return f.GetStageInstanceFunc(ctx, pipeline, pipelineCounter, stage, stageCounter)
+}
+
+func (f *Fake) GetStageHistory(ctx context.Context, pipeline, stage string, opts *types.PageOptions) (*types.StageHistory, error) {
+	f.record("GetStageHistory", pipeline, stage, opts)
+	if f.GetStageHistoryFunc == nil {
+		return nil, notConfigured("GetStageHistory")
+	}
+
+	return f.GetStageHistoryFunc(ctx, pipeline, stage, opts)
+}
+
+// StageHistory falls back to iterating over a single GetStageHistoryFunc page when
+// StageHistoryFunc is not set.
+func (f *Fake) StageHistory(pipeline, stage string, opts *types.PageOptions) *client.Iterator[types.StageInstance] {
+	f.record("StageHistory", pipeline, stage, opts)
+
+	if f.StageHistoryFunc != nil {
+		return f.StageHistoryFunc(pipeline, stage, opts)
+	}
+
+	if f.GetStageHistoryFunc == nil {
+		return client.NewSliceIterator[types.StageInstance](nil, notConfigured("StageHistory"))
+	}
+
+	history, err := f.GetStageHistoryFunc(context.Background(), pipeline, stage, opts)
+	if err != nil {
+		return client.NewSliceIterator[types.StageInstance](nil, err)
+	}
+
+	return client.NewSliceIterator(history.Stages, nil)
+}
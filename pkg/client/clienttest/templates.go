@@ -0,0 +1,106 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetAllTemplates(ctx context.Context) (*types.AllTemplates, error) {
+	f.record("GetAllTemplates")
+	if f.GetAllTemplatesFunc == nil {
+		return nil, notConfigured("GetAllTemplates")
+	}
+
+	return f.GetAllTemplatesFunc(ctx)
+}
+
+func (f *Fake) GetTemplate(ctx context.Context, name string) (*types.TemplateConfig, error) {
+	f.record("GetTemplate", name)
+	if f.GetTemplateFunc == nil {
+		return nil, notConfigured("GetTemplate")
+	}
+
+	return f.GetTemplateFunc(ctx, name)
+}
+
+func (f *Fake) GetTemplateWithETag(ctx context.Context, name string) (*types.TemplateConfig, string, error) {
+	f.record("GetTemplateWithETag", name)
+	if f.GetTemplateWithETagFunc == nil {
+		return nil, "", notConfigured("GetTemplateWithETag")
+	}
+
+	return f.GetTemplateWithETagFunc(ctx, name)
+}
+
+func (f *Fake) CreateTemplate(ctx context.Context, template *types.TemplateConfig) (*types.TemplateConfig, error) {
+	f.record("CreateTemplate", template)
+	if f.CreateTemplateFunc == nil {
+		return nil, notConfigured("CreateTemplate")
+	}
+
+	return f.CreateTemplateFunc(ctx, template)
+}
+
+func (f *Fake) UpdateTemplate(ctx context.Context, template *types.TemplateConfig, eTag string) (*types.TemplateConfig, error) {
+	f.record("UpdateTemplate", template, eTag)
+	if f.UpdateTemplateFunc == nil {
+		return nil, notConfigured("UpdateTemplate")
+	}
+
+	return f.UpdateTemplateFunc(ctx, template, eTag)
+}
+
+func (f *Fake) DeleteTemplate(ctx context.Context, name string) (string, error) {
+	f.record("DeleteTemplate", name)
+	if f.DeleteTemplateFunc == nil {
+		return "", notConfigured("DeleteTemplate")
+	}
+
+	return f.DeleteTemplateFunc(ctx, name)
+}
+
+func (f *Fake) ModifyTemplate(ctx context.Context, name string, mutate func(*types.TemplateConfig) error) (*types.TemplateConfig, error) {
+	f.record("ModifyTemplate", name, mutate)
+	if f.ModifyTemplateFunc == nil {
+		return nil, notConfigured("ModifyTemplate")
+	}
+
+	return f.ModifyTemplateFunc(ctx, name, mutate)
+}
+
+func (f *Fake) GetTemplateAuthorization(ctx context.Context, name string) (*types.TemplateAuthorization, error) {
+	f.record("GetTemplateAuthorization", name)
+	if f.GetTemplateAuthorizationFunc == nil {
+		return nil, notConfigured("GetTemplateAuthorization")
+	}
+
+	return f.GetTemplateAuthorizationFunc(ctx, name)
+}
+
+func (f *Fake) GetTemplateAuthorizationWithETag(ctx context.Context, name string) (*types.TemplateAuthorization, string, error) {
+	f.record("GetTemplateAuthorizationWithETag", name)
+	if f.GetTemplateAuthorizationWithETagFunc == nil {
+		return nil, "", notConfigured("GetTemplateAuthorizationWithETag")
+	}
+
+	return f.GetTemplateAuthorizationWithETagFunc(ctx, name)
+}
+
+func (f *Fake) UpdateTemplateAuthorization(ctx context.Context, name string, authorization *types.TemplateAuthorization, eTag string) (*types.TemplateAuthorization, error) {
+	f.record("UpdateTemplateAuthorization", name, authorization, eTag)
+	if f.UpdateTemplateAuthorizationFunc == nil {
+		return nil, notConfigured("UpdateTemplateAuthorization")
+	}
+
+	return f.UpdateTemplateAuthorizationFunc(ctx, name, authorization, eTag)
+}
+
+func (f *Fake) ModifyTemplateAuthorization(ctx context.Context, name string, mutate func(*types.TemplateAuthorization) error) (*types.TemplateAuthorization, error) {
+	f.record("ModifyTemplateAuthorization", name, mutate)
+	if f.ModifyTemplateAuthorizationFunc == nil {
+		return nil, notConfigured("ModifyTemplateAuthorization")
+	}
+
+	return f.ModifyTemplateAuthorizationFunc(ctx, name, mutate)
+}
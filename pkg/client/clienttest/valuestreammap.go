@@ -0,0 +1,25 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetPipelineValueStreamMap(ctx context.Context, pipeline string, counter int) (*types.ValueStreamMap, error) {
+	f.record("GetPipelineValueStreamMap", pipeline, counter)
+	if f.GetPipelineValueStreamMapFunc == nil {
+		return nil, notConfigured("GetPipelineValueStreamMap")
+	}
+
+	return f.GetPipelineValueStreamMapFunc(ctx, pipeline, counter)
+}
+
+func (f *Fake) GetMaterialValueStreamMap(ctx context.Context, fingerprint, revision string) (*types.ValueStreamMap, error) {
+	f.record("GetMaterialValueStreamMap", fingerprint, revision)
+	if f.GetMaterialValueStreamMapFunc == nil {
+		return nil, notConfigured("GetMaterialValueStreamMap")
+	}
+
+	return f.GetMaterialValueStreamMapFunc(ctx, fingerprint, revision)
+}
@@ -0,0 +1,16 @@
+package clienttest
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+func (f *Fake) GetVersion(ctx context.Context) (*types.Version, error) {
+	f.record("GetVersion")
+	if f.GetVersionFunc == nil {
+		return nil, notConfigured("GetVersion")
+	}
+
+	return f.GetVersionFunc(ctx)
+}
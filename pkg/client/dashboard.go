@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/dashboard"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type DashboardAPI interface {
+	GetDashboard(ctx context.Context) (*types.Dashboard, error)
+}
+
+// GetDashboard returns the dashboard of the authenticated user, see the filters of types.Dashboard.
+func (c *Client) GetDashboard(ctx context.Context) (*types.Dashboard, error) {
+	return dashboard.GetDashboard(ctx, c.client)
+}
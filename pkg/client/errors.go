@@ -0,0 +1,47 @@
+package client
+
+import (
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+)
+
+// APIError is returned for every non-2xx response, use errors.As to inspect it.
+type APIError = client.APIError
+
+var (
+	ErrBadRequest         = client.ErrBadRequest
+	ErrUnauthorized       = client.ErrUnauthorized
+	ErrForbidden          = client.ErrForbidden
+	ErrNotFound           = client.ErrNotFound
+	ErrNotAcceptable      = client.ErrNotAcceptable
+	ErrConflict           = client.ErrConflict
+	ErrPreconditionFailed = client.ErrPreconditionFailed
+	ErrUnprocessable      = client.ErrUnprocessable
+	ErrTooManyRequests    = client.ErrTooManyRequests
+	ErrServerError        = client.ErrServerError
+	// ErrInvalidPageSize is returned, without sending the request, for a PageSize GoCD rejects.
+	ErrInvalidPageSize = client.ErrInvalidPageSize
+)
+
+func IsNotFound(err error) bool {
+	return client.IsNotFound(err)
+}
+
+func IsConflict(err error) bool {
+	return client.IsConflict(err)
+}
+
+func IsPreconditionFailed(err error) bool {
+	return client.IsPreconditionFailed(err)
+}
+
+func IsUnauthorized(err error) bool {
+	return client.IsUnauthorized(err)
+}
+
+func IsForbidden(err error) bool {
+	return client.IsForbidden(err)
+}
+
+func IsUnprocessable(err error) bool {
+	return client.IsUnprocessable(err)
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/export"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type PipelineExportAPI interface {
+	ExportPipeline(ctx context.Context, pipeline, pluginId string) (*types.PipelineExport, error)
+	ExportPipelineGroup(ctx context.Context, group, pluginId, dir string) ([]string, error)
+}
+
+// ExportPipeline serializes the pipeline with a config repo plugin, e.g. types.ConfigRepoPluginYAML.
+func (c *Client) ExportPipeline(ctx context.Context, pipeline, pluginId string) (*types.PipelineExport, error) {
+	return export.ExportPipeline(ctx, c.client, pipeline, pluginId)
+}
+
+// ExportPipelineGroup writes every pipeline of the group to dir, named after the file name
+// the plugin suggests, and returns the paths written. Existing files are overwritten.
+func (c *Client) ExportPipelineGroup(ctx context.Context, group, pluginId, dir string) ([]string, error) {
+	return export.ExportPipelineGroup(ctx, c.client, group, pluginId, dir)
+}
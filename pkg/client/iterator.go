@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+)
+
+// Iterator walks a paginated collection, fetching pages lazily as Next is called:
+//
+//	it := c.PipelineHistory("build", &types.PageOptions{PageSize: 50})
+//	for it.Next(ctx) {
+//		run := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// Stopping the loop early is enough to end the iteration, no further page is requested.
+type Iterator[T any] struct {
+	pager *client.Pager[T]
+}
+
+// NewSliceIterator yields items and then stops with err, e.g. to program a clienttest.Fake.
+func NewSliceIterator[T any](items []T, err error) *Iterator[T] {
+	return &Iterator[T]{pager: client.NewStaticPager(items, err)}
+}
+
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	return it.pager.Next(ctx)
+}
+
+func (it *Iterator[T]) Value() T {
+	return it.pager.Value()
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.pager.Err()
+}
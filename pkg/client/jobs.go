@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/jobs"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type JobsAPI interface {
+	GetJobInstance(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) (*types.JobInstance, error)
+	GetJobHistory(ctx context.Context, pipeline, stage, job string, opts *types.PageOptions) (*types.JobHistory, error)
+	JobHistory(pipeline, stage, job string, opts *types.PageOptions) *Iterator[types.JobInstance]
+	FollowConsoleLog(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string, opts *types.ConsoleLogOptions) io.ReadCloser
+}
+
+func (c *Client) GetJobInstance(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string) (*types.JobInstance, error) {
+	return jobs.GetJobInstance(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job)
+}
+
+// GetJobHistory returns a single page of runs of the job, newest first, opts may be nil.
+func (c *Client) GetJobHistory(ctx context.Context, pipeline, stage, job string, opts *types.PageOptions) (*types.JobHistory, error) {
+	return jobs.GetJobHistory(ctx, c.client, pipeline, stage, job, opts)
+}
+
+// JobHistory iterates over the runs of the job, newest first, opts may be nil.
+func (c *Client) JobHistory(pipeline, stage, job string, opts *types.PageOptions) *Iterator[types.JobInstance] {
+	return &Iterator[types.JobInstance]{pager: jobs.JobHistory(c.client, pipeline, stage, job, opts)}
+}
+
+// FollowConsoleLog streams the console output of the job while it runs, the reader reaches
+// io.EOF once the job completed. Errors, including the cancellation of ctx, surface from
+// Read; close the reader to stop following early. opts may be nil.
+func (c *Client) FollowConsoleLog(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, job string, opts *types.ConsoleLogOptions) io.ReadCloser {
+	return jobs.FollowConsoleLog(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, job, opts)
+}
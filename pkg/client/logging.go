@@ -0,0 +1,21 @@
+package client
+
+import (
+	"log/slog"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/logging"
+)
+
+// Logger receives the client logs, args are alternating key/value pairs like in log/slog.
+// Every record carries the "module", "method" and "url" of the request it belongs to.
+type Logger = logging.Logger
+
+// NewSlogLogger adapts a *slog.Logger, a nil logger uses slog.Default().
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return logging.NewSlogLogger(logger)
+}
+
+// NopLogger discards every record.
+func NopLogger() Logger {
+	return logging.Nop()
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/client"
+)
+
+const defaultTimeout = time.Minute
+
+type options struct {
+	httpClient *http.Client
+	timeout    *time.Duration
+	transport  http.RoundTripper
+	tlsConfig  *tls.Config
+	userAgent  string
+	headers    http.Header
+	logger     Logger
+	debug      bool
+	retry      *RetryPolicy
+	modify     int
+	negotiate  bool
+	version    string
+	middleware []Middleware
+	auth       func(c *client.Client)
+}
+
+// Option configures a Client when passed to NewClient, options can be given in any order.
+type Option func(o *options)
+
+// RetryPolicy controls how failed requests are retried, see DefaultRetryPolicy.
+type RetryPolicy = client.RetryPolicy
+
+// DefaultRetryPolicy retries 429, 502, 503 and 504 responses of idempotent requests
+// up to 4 attempts with exponential backoff and jitter, honoring Retry-After.
+func DefaultRetryPolicy() RetryPolicy {
+	return client.DefaultRetryPolicy()
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = &policy
+	}
+}
+
+// WithModifyAttempts bounds how many times the Modify* helpers re-fetch and re-apply
+// their mutation after a 412 Precondition Failed, the default is 3.
+func WithModifyAttempts(attempts int) Option {
+	return func(o *options) {
+		o.modify = attempts
+	}
+}
+
+// WithAPIVersionNegotiation detects the server release through /api/version on first use
+// and sends every request with the newest Accept version that release serves.
+//
+// By default the client sends the newest Accept version it knows for each endpoint and
+// only falls back to older ones when the server rejects it, with 406 Not Acceptable or,
+// on releases predating that version, 404 Not Found. A 404 the older versions do not
+// resolve is returned unchanged.
+func WithAPIVersionNegotiation() Option {
+	return func(o *options) {
+		o.negotiate = true
+	}
+}
+
+// WithServerVersion negotiates API versions for a known GoCD release, e.g. "23.1.0",
+// without querying /api/version.
+func WithServerVersion(version string) Option {
+	return func(o *options) {
+		o.negotiate = true
+		o.version = version
+	}
+}
+
+// WithHTTPClient uses a copy of httpClient, the other options never modify the original.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = &timeout
+	}
+}
+
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithTLSConfig requires the transport in use to be an *http.Transport.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// Middleware hooks into every request made by the client, all hooks are optional.
+type Middleware = client.Middleware
+
+// WithMiddleware appends middlewares to the chain, they run in the order they were added.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *options) {
+		o.middleware = append(o.middleware, middleware...)
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithDefaultHeaders adds headers to every request, unless the request sets them itself.
+func WithDefaultHeaders(headers http.Header) Option {
+	return func(o *options) {
+		o.headers = headers.Clone()
+	}
+}
+
+// WithLogger routes the client logs to logger, by default nothing is logged.
+func WithLogger(logger Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithDebug logs every request to stderr at debug level, unless WithLogger is given.
+func WithDebug() Option {
+	return func(o *options) {
+		o.debug = true
+	}
+}
+
+func WithBasicAuth(user, password string) Option {
+	return func(o *options) {
+		o.auth = func(c *client.Client) {
+			c.SetBasicAuth(user, password)
+		}
+	}
+}
+
+func WithAccessToken(token string) Option {
+	return func(o *options) {
+		o.auth = func(c *client.Client) {
+			c.SetAccessToken(token)
+		}
+	}
+}
+
+func (o *options) buildHTTPClient() (*http.Client, error) {
+	httpClient := &http.Client{
+		Timeout: defaultTimeout,
+	}
+
+	if o.httpClient != nil {
+		copied := *o.httpClient
+		httpClient = &copied
+	}
+
+	if o.timeout != nil {
+		httpClient.Timeout = *o.timeout
+	}
+
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+
+	if o.tlsConfig == nil {
+		return httpClient, nil
+	}
+
+	var transport *http.Transport
+
+	switch t := httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, errors.New("WithTLSConfig requires an *http.Transport")
+	}
+
+	transport.TLSClientConfig = o.tlsConfig
+	httpClient.Transport = transport
+
+	return httpClient, nil
+}
+
+func (o *options) apply(c *client.Client) error {
+	httpClient, err := o.buildHTTPClient()
+	if err != nil {
+		return err
+	}
+
+	c.HttpClient = httpClient
+	c.UserAgent = o.userAgent
+	c.Headers = o.headers
+	c.Logger = o.logger
+	c.Debug = o.debug
+	c.Retry = o.retry
+	c.ModifyAttempts = o.modify
+	c.Negotiate = o.negotiate
+	c.ServerVersion = o.version
+	c.Middlewares = o.middleware
+
+	if o.auth != nil {
+		o.auth(c)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripper struct{}
+
+func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewClientOptions(t *testing.T) {
+	t.Parallel()
+
+	var got http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		_, err := w.Write([]byte(`{"version": "23.1.0"}`))
+		if err != nil {
+			t.Errorf("failed to write body: '%s'", err.Error())
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient(
+		ts.URL,
+		WithAccessToken("token"),
+		WithUserAgent("gocd-test/1.0"),
+		WithDefaultHeaders(http.Header{
+			"X-Team": []string{"platform"},
+			"Accept": []string{"text/plain"},
+		}),
+		WithTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, c.client.HttpClient.Timeout)
+
+	version, err := c.GetVersion(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "23.1.0", version.Version)
+
+	assert.Equal(t, "Bearer token", got.Get("Authorization"))
+	assert.Equal(t, "gocd-test/1.0", got.Get("User-Agent"))
+	assert.Equal(t, "platform", got.Get("X-Team"))
+	assert.Equal(t, "application/vnd.go.cd.v1+json", got.Get("Accept"))
+}
+
+func TestNewClientHTTPOptions(t *testing.T) {
+	t.Parallel()
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		check   func(t *testing.T, httpClient *http.Client)
+		wantErr bool
+	}{
+		{
+			name: "Defaults",
+			check: func(t *testing.T, httpClient *http.Client) {
+				assert.Equal(t, defaultTimeout, httpClient.Timeout)
+				assert.Nil(t, httpClient.Transport)
+			},
+		},
+		{
+			name: "Custom http client is copied",
+			opts: func() []Option {
+				original := &http.Client{Timeout: time.Second}
+
+				return []Option{WithTimeout(time.Hour), WithHTTPClient(original)}
+			}(),
+			check: func(t *testing.T, httpClient *http.Client) {
+				assert.Equal(t, time.Hour, httpClient.Timeout)
+			},
+		},
+		{
+			name: "TLS config on default transport",
+			opts: []Option{WithTLSConfig(tlsConfig)},
+			check: func(t *testing.T, httpClient *http.Client) {
+				transport, ok := httpClient.Transport.(*http.Transport)
+				require.True(t, ok)
+				assert.Same(t, tlsConfig, transport.TLSClientConfig)
+			},
+		},
+		{
+			name:    "TLS config on custom round tripper",
+			opts:    []Option{WithTLSConfig(tlsConfig), WithTransport(&roundTripper{})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := NewClient("https://fake.com/go", tt.opts...)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, c.client.HttpClient)
+		})
+	}
+}
@@ -1,30 +1,48 @@
 package client
 
 import (
+	"context"
+
 	"github.com/AlinScreciu/gocd-go-api-client/internal/packages"
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
 )
 
-func (c *Client) GetAllPackages() (*types.AllPackages, error) {
-	return packages.GetAllPackages(c.client)
+type PackagesAPI interface {
+	GetAllPackages(ctx context.Context) (*types.AllPackages, error)
+	CreatePackage(ctx context.Context, pkg *types.Package) (*types.Package, error)
+	GetPackage(ctx context.Context, packageId string) (*types.Package, error)
+	GetPackageWithETag(ctx context.Context, packageId string) (*types.Package, string, error)
+	UpdatePackage(ctx context.Context, pkg *types.Package, eTag string) (*types.Package, error)
+	DeletePackage(ctx context.Context, packageId string) (string, error)
+	ModifyPackage(ctx context.Context, packageId string, mutate func(*types.Package) error) (*types.Package, error)
+}
+
+func (c *Client) GetAllPackages(ctx context.Context) (*types.AllPackages, error) {
+	return packages.GetAllPackages(ctx, c.client)
+}
+
+func (c *Client) CreatePackage(ctx context.Context, pkg *types.Package) (*types.Package, error) {
+	return packages.CreatePackage(ctx, c.client, pkg)
 }
 
-func (c *Client) CreatePackage(pkg *types.Package) (*types.Package, error) {
-	return packages.CreatePackage(c.client, pkg)
+func (c *Client) GetPackage(ctx context.Context, packageId string) (*types.Package, error) {
+	return packages.GetPackage(ctx, c.client, packageId)
 }
 
-func (c *Client) GetPackage(packageId string) (*types.Package, error) {
-	return packages.GetPackage(c.client, packageId)
+func (c *Client) GetPackageWithETag(ctx context.Context, packageId string) (*types.Package, string, error) {
+	return packages.GetPackageWithETag(ctx, c.client, packageId)
 }
 
-func (c *Client) GetPackageWithETag(packageId string) (*types.Package, string, error) {
-	return packages.GetPackageWithETag(c.client, packageId)
+func (c *Client) UpdatePackage(ctx context.Context, pkg *types.Package, eTag string) (*types.Package, error) {
+	return packages.UpdatePackage(ctx, c.client, pkg, eTag)
 }
 
-func (c *Client) UpdatePackage(pkg *types.Package, eTag string) (*types.Package, error) {
-	return packages.UpdatePackage(c.client, pkg, eTag)
+func (c *Client) DeletePackage(ctx context.Context, packageId string) (string, error) {
+	return packages.DeletePackage(ctx, c.client, packageId)
 }
 
-func (c *Client) DeletePackage(packageId string) (string, error) {
-	return packages.DeletePackage(c.client, packageId)
+// ModifyPackage fetches the package, applies mutate and saves it, starting over when the
+// package was changed by somebody else in the meantime, see WithModifyAttempts.
+func (c *Client) ModifyPackage(ctx context.Context, packageId string, mutate func(*types.Package) error) (*types.Package, error) {
+	return packages.ModifyPackage(ctx, c.client, packageId, mutate)
 }
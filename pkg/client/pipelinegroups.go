@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelinegroups"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type PipelineGroupsAPI interface {
+	GetAllPipelineGroups(ctx context.Context) (*types.AllPipelineGroups, error)
+	GetPipelineGroup(ctx context.Context, name string) (*types.PipelineGroup, error)
+	GetPipelineGroupWithETag(ctx context.Context, name string) (*types.PipelineGroup, string, error)
+	CreatePipelineGroup(ctx context.Context, group *types.PipelineGroup) (*types.PipelineGroup, error)
+	UpdatePipelineGroup(ctx context.Context, group *types.PipelineGroup, eTag string) (*types.PipelineGroup, error)
+	DeletePipelineGroup(ctx context.Context, name string) (string, error)
+	ModifyPipelineGroup(ctx context.Context, name string, mutate func(*types.PipelineGroup) error) (*types.PipelineGroup, error)
+	GrantPipelineGroupRole(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error)
+	RevokePipelineGroupRole(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error)
+}
+
+func (c *Client) GetAllPipelineGroups(ctx context.Context) (*types.AllPipelineGroups, error) {
+	return pipelinegroups.GetAllPipelineGroups(ctx, c.client)
+}
+
+func (c *Client) GetPipelineGroup(ctx context.Context, name string) (*types.PipelineGroup, error) {
+	return pipelinegroups.GetPipelineGroup(ctx, c.client, name)
+}
+
+func (c *Client) GetPipelineGroupWithETag(ctx context.Context, name string) (*types.PipelineGroup, string, error) {
+	return pipelinegroups.GetPipelineGroupWithETag(ctx, c.client, name)
+}
+
+func (c *Client) CreatePipelineGroup(ctx context.Context, group *types.PipelineGroup) (*types.PipelineGroup, error) {
+	return pipelinegroups.CreatePipelineGroup(ctx, c.client, group)
+}
+
+func (c *Client) UpdatePipelineGroup(ctx context.Context, group *types.PipelineGroup, eTag string) (*types.PipelineGroup, error) {
+	return pipelinegroups.UpdatePipelineGroup(ctx, c.client, group, eTag)
+}
+
+func (c *Client) DeletePipelineGroup(ctx context.Context, name string) (string, error) {
+	return pipelinegroups.DeletePipelineGroup(ctx, c.client, name)
+}
+
+// ModifyPipelineGroup fetches the pipeline group, applies mutate and saves it, starting
+// over when the group was changed by somebody else in the meantime.
+func (c *Client) ModifyPipelineGroup(ctx context.Context, name string, mutate func(*types.PipelineGroup) error) (*types.PipelineGroup, error) {
+	return pipelinegroups.ModifyPipelineGroup(ctx, c.client, name, mutate)
+}
+
+// GrantPipelineGroupRole adds role to the permission of the group through ModifyPipelineGroup.
+// Granting the first permission of an open group restricts it, see PipelineGroupAuthorization.
+func (c *Client) GrantPipelineGroupRole(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error) {
+	return pipelinegroups.GrantPipelineGroupRole(ctx, c.client, name, permission, role)
+}
+
+// RevokePipelineGroupRole removes role from the permission of the group through ModifyPipelineGroup.
+func (c *Client) RevokePipelineGroupRole(ctx context.Context, name string, permission types.PipelineGroupPermission, role string) (*types.PipelineGroup, error) {
+	return pipelinegroups.RevokePipelineGroupRole(ctx, c.client, name, permission, role)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelineinstances"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type PipelineInstancesAPI interface {
+	GetPipelineHistory(ctx context.Context, name string, opts *types.PageOptions) (*types.PipelineHistory, error)
+	PipelineHistory(name string, opts *types.PageOptions) *Iterator[types.PipelineInstance]
+	GetPipelineInstance(ctx context.Context, name string, counter int) (*types.PipelineInstance, error)
+	ComparePipelineInstances(ctx context.Context, name string, from, to int) (*types.PipelineComparison, error)
+}
+
+// GetPipelineHistory returns a single page of runs, newest first, opts may be nil.
+func (c *Client) GetPipelineHistory(ctx context.Context, name string, opts *types.PageOptions) (*types.PipelineHistory, error) {
+	return pipelineinstances.GetPipelineHistory(ctx, c.client, name, opts)
+}
+
+// PipelineHistory iterates over the runs of the pipeline, newest first, opts may be nil.
+func (c *Client) PipelineHistory(name string, opts *types.PageOptions) *Iterator[types.PipelineInstance] {
+	return &Iterator[types.PipelineInstance]{pager: pipelineinstances.PipelineHistory(c.client, name, opts)}
+}
+
+func (c *Client) GetPipelineInstance(ctx context.Context, name string, counter int) (*types.PipelineInstance, error) {
+	return pipelineinstances.GetPipelineInstance(ctx, c.client, name, counter)
+}
+
+// ComparePipelineInstances returns what changed between two runs of the pipeline, render it
+// with Markdown for release notes.
+func (c *Client) ComparePipelineInstances(ctx context.Context, name string, from, to int) (*types.PipelineComparison, error) {
+	return pipelineinstances.ComparePipelineInstances(ctx, c.client, name, from, to)
+}
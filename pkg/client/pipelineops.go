@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelineops"
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelinerun"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+// PipelineRunError is returned by RunPipeline for a run that did not pass, it names the
+// stage the run stopped at and the jobs that failed in it. Use errors.As to inspect it.
+type PipelineRunError = pipelinerun.RunError
+
+type PipelineOperationsAPI interface {
+	PausePipeline(ctx context.Context, name, cause string) (string, error)
+	UnpausePipeline(ctx context.Context, name string) (string, error)
+	UnlockPipeline(ctx context.Context, name string) (string, error)
+	SchedulePipeline(ctx context.Context, name string, opts *types.ScheduleOptions) (string, error)
+	RunPipeline(ctx context.Context, name string, opts *types.RunPipelineOptions) (*types.PipelineInstance, error)
+}
+
+func (c *Client) PausePipeline(ctx context.Context, name, cause string) (string, error) {
+	return pipelineops.PausePipeline(ctx, c.client, name, cause)
+}
+
+func (c *Client) UnpausePipeline(ctx context.Context, name string) (string, error) {
+	return pipelineops.UnpausePipeline(ctx, c.client, name)
+}
+
+func (c *Client) UnlockPipeline(ctx context.Context, name string) (string, error) {
+	return pipelineops.UnlockPipeline(ctx, c.client, name)
+}
+
+// SchedulePipeline triggers a run of the pipeline, opts may be nil.
+func (c *Client) SchedulePipeline(ctx context.Context, name string, opts *types.ScheduleOptions) (string, error) {
+	if opts == nil {
+		opts = &types.ScheduleOptions{}
+	}
+
+	return pipelineops.SchedulePipeline(ctx, c.client, name, opts)
+}
+
+// RunPipeline schedules the pipeline and waits for the run to pass, fail, or stop at a stage
+// waiting for manual approval, opts may be nil. A run that did not pass is returned along
+// with a *PipelineRunError, cancelling ctx stops the wait and returns the last instance seen.
+func (c *Client) RunPipeline(ctx context.Context, name string, opts *types.RunPipelineOptions) (*types.PipelineInstance, error) {
+	return pipelinerun.RunPipeline(ctx, c.client, name, opts)
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelines"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type PipelineConfigsAPI interface {
+	GetPipelineConfig(ctx context.Context, name string) (*types.PipelineConfig, error)
+	GetPipelineConfigWithETag(ctx context.Context, name string) (*types.PipelineConfig, string, error)
+	CreatePipelineConfig(ctx context.Context, group string, pipeline *types.PipelineConfig) (*types.PipelineConfig, error)
+	UpdatePipelineConfig(ctx context.Context, pipeline *types.PipelineConfig, eTag string) (*types.PipelineConfig, error)
+	DeletePipelineConfig(ctx context.Context, name string) (string, error)
+	ModifyPipelineConfig(ctx context.Context, name string, mutate func(*types.PipelineConfig) error) (*types.PipelineConfig, error)
+}
+
+func (c *Client) GetPipelineConfig(ctx context.Context, name string) (*types.PipelineConfig, error) {
+	return pipelines.GetPipelineConfig(ctx, c.client, name)
+}
+
+func (c *Client) GetPipelineConfigWithETag(ctx context.Context, name string) (*types.PipelineConfig, string, error) {
+	return pipelines.GetPipelineConfigWithETag(ctx, c.client, name)
+}
+
+func (c *Client) CreatePipelineConfig(ctx context.Context, group string, pipeline *types.PipelineConfig) (*types.PipelineConfig, error) {
+	return pipelines.CreatePipelineConfig(ctx, c.client, group, pipeline)
+}
+
+func (c *Client) UpdatePipelineConfig(ctx context.Context, pipeline *types.PipelineConfig, eTag string) (*types.PipelineConfig, error) {
+	return pipelines.UpdatePipelineConfig(ctx, c.client, pipeline, eTag)
+}
+
+func (c *Client) DeletePipelineConfig(ctx context.Context, name string) (string, error) {
+	return pipelines.DeletePipelineConfig(ctx, c.client, name)
+}
+
+// ModifyPipelineConfig fetches the pipeline config, applies mutate and saves it, starting
+// over when the pipeline was changed by somebody else in the meantime.
+func (c *Client) ModifyPipelineConfig(ctx context.Context, name string, mutate func(*types.PipelineConfig) error) (*types.PipelineConfig, error) {
+	return pipelines.ModifyPipelineConfig(ctx, c.client, name, mutate)
+}
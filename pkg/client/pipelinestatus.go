@@ -0,0 +1,16 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/pipelinestatus"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type PipelineStatusAPI interface {
+	GetPipelineStatus(ctx context.Context, name string) (*types.PipelineStatus, error)
+}
+
+func (c *Client) GetPipelineStatus(ctx context.Context, name string) (*types.PipelineStatus, error) {
+	return pipelinestatus.GetPipelineStatus(ctx, c.client, name)
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/stages"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type StagesAPI interface {
+	RunStage(ctx context.Context, pipeline string, pipelineCounter int, stage string) (string, error)
+	CancelStage(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error)
+	RunFailedJobs(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error)
+	RunSelectedJobs(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, jobs []string) (string, error)
+	GetStageInstance(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (*types.StageInstance, error)
+	GetStageHistory(ctx context.Context, pipeline, stage string, opts *types.PageOptions) (*types.StageHistory, error)
+	StageHistory(pipeline, stage string, opts *types.PageOptions) *Iterator[types.StageInstance]
+}
+
+func (c *Client) RunStage(ctx context.Context, pipeline string, pipelineCounter int, stage string) (string, error) {
+	return stages.RunStage(ctx, c.client, pipeline, pipelineCounter, stage)
+}
+
+func (c *Client) CancelStage(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error) {
+	return stages.CancelStage(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter)
+}
+
+func (c *Client) RunFailedJobs(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (string, error) {
+	return stages.RunFailedJobs(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter)
+}
+
+func (c *Client) RunSelectedJobs(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int, jobs []string) (string, error) {
+	return stages.RunSelectedJobs(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter, jobs)
+}
+
+func (c *Client) GetStageInstance(ctx context.Context, pipeline string, pipelineCounter int, stage string, stageCounter int) (*types.StageInstance, error) {
+	return stages.GetStageInstance(ctx, c.client, pipeline, pipelineCounter, stage, stageCounter)
+}
+
+// GetStageHistory returns a single page of runs of the stage, newest first, opts may be nil.
+func (c *Client) GetStageHistory(ctx context.Context, pipeline, stage string, opts *types.PageOptions) (*types.StageHistory, error) {
+	return stages.GetStageHistory(ctx, c.client, pipeline, stage, opts)
+}
+
+// StageHistory iterates over the runs of the stage, newest first, opts may be nil.
+func (c *Client) StageHistory(pipeline, stage string, opts *types.PageOptions) *Iterator[types.StageInstance] {
+	return &Iterator[types.StageInstance]{pager: stages.StageHistory(c.client, pipeline, stage, opts)}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/templates"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type TemplatesAPI interface {
+	GetAllTemplates(ctx context.Context) (*types.AllTemplates, error)
+	GetTemplate(ctx context.Context, name string) (*types.TemplateConfig, error)
+	GetTemplateWithETag(ctx context.Context, name string) (*types.TemplateConfig, string, error)
+	CreateTemplate(ctx context.Context, template *types.TemplateConfig) (*types.TemplateConfig, error)
+	UpdateTemplate(ctx context.Context, template *types.TemplateConfig, eTag string) (*types.TemplateConfig, error)
+	DeleteTemplate(ctx context.Context, name string) (string, error)
+	ModifyTemplate(ctx context.Context, name string, mutate func(*types.TemplateConfig) error) (*types.TemplateConfig, error)
+	GetTemplateAuthorization(ctx context.Context, name string) (*types.TemplateAuthorization, error)
+	GetTemplateAuthorizationWithETag(ctx context.Context, name string) (*types.TemplateAuthorization, string, error)
+	UpdateTemplateAuthorization(ctx context.Context, name string, authorization *types.TemplateAuthorization, eTag string) (*types.TemplateAuthorization, error)
+	ModifyTemplateAuthorization(ctx context.Context, name string, mutate func(*types.TemplateAuthorization) error) (*types.TemplateAuthorization, error)
+}
+
+// GetAllTemplates lists the templates along with the pipelines using each of them.
+func (c *Client) GetAllTemplates(ctx context.Context) (*types.AllTemplates, error) {
+	return templates.GetAllTemplates(ctx, c.client)
+}
+
+func (c *Client) GetTemplate(ctx context.Context, name string) (*types.TemplateConfig, error) {
+	return templates.GetTemplate(ctx, c.client, name)
+}
+
+func (c *Client) GetTemplateWithETag(ctx context.Context, name string) (*types.TemplateConfig, string, error) {
+	return templates.GetTemplateWithETag(ctx, c.client, name)
+}
+
+func (c *Client) CreateTemplate(ctx context.Context, template *types.TemplateConfig) (*types.TemplateConfig, error) {
+	return templates.CreateTemplate(ctx, c.client, template)
+}
+
+func (c *Client) UpdateTemplate(ctx context.Context, template *types.TemplateConfig, eTag string) (*types.TemplateConfig, error) {
+	return templates.UpdateTemplate(ctx, c.client, template, eTag)
+}
+
+func (c *Client) DeleteTemplate(ctx context.Context, name string) (string, error) {
+	return templates.DeleteTemplate(ctx, c.client, name)
+}
+
+// ModifyTemplate fetches the template, applies mutate and saves it, starting over when the
+// template was changed by somebody else in the meantime.
+func (c *Client) ModifyTemplate(ctx context.Context, name string, mutate func(*types.TemplateConfig) error) (*types.TemplateConfig, error) {
+	return templates.ModifyTemplate(ctx, c.client, name, mutate)
+}
+
+func (c *Client) GetTemplateAuthorization(ctx context.Context, name string) (*types.TemplateAuthorization, error) {
+	return templates.GetTemplateAuthorization(ctx, c.client, name)
+}
+
+func (c *Client) GetTemplateAuthorizationWithETag(ctx context.Context, name string) (*types.TemplateAuthorization, string, error) {
+	return templates.GetTemplateAuthorizationWithETag(ctx, c.client, name)
+}
+
+func (c *Client) UpdateTemplateAuthorization(ctx context.Context, name string, authorization *types.TemplateAuthorization, eTag string) (*types.TemplateAuthorization, error) {
+	return templates.UpdateTemplateAuthorization(ctx, c.client, name, authorization, eTag)
+}
+
+// ModifyTemplateAuthorization is ModifyTemplate for the authorization of the template.
+func (c *Client) ModifyTemplateAuthorization(ctx context.Context, name string, mutate func(*types.TemplateAuthorization) error) (*types.TemplateAuthorization, error) {
+	return templates.ModifyTemplateAuthorization(ctx, c.client, name, mutate)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/valuestreammap"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+type ValueStreamMapAPI interface {
+	GetPipelineValueStreamMap(ctx context.Context, pipeline string, counter int) (*types.ValueStreamMap, error)
+	GetMaterialValueStreamMap(ctx context.Context, fingerprint, revision string) (*types.ValueStreamMap, error)
+}
+
+// GetPipelineValueStreamMap returns the value stream map of an instance of the pipeline, e.g.
+// to list the upstream materials it was built from with UpstreamMaterials(pipeline).
+func (c *Client) GetPipelineValueStreamMap(ctx context.Context, pipeline string, counter int) (*types.ValueStreamMap, error) {
+	return valuestreammap.GetPipelineValueStreamMap(ctx, c.client, pipeline, counter)
+}
+
+// GetMaterialValueStreamMap returns the value stream map of a revision of the material
+// identified by its fingerprint.
+func (c *Client) GetMaterialValueStreamMap(ctx context.Context, fingerprint, revision string) (*types.ValueStreamMap, error) {
+	return valuestreammap.GetMaterialValueStreamMap(ctx, c.client, fingerprint, revision)
+}
@@ -1,10 +1,16 @@
 package client
 
 import (
+	"context"
+
 	"github.com/AlinScreciu/gocd-go-api-client/internal/version"
 	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
 )
 
-func (c *Client) GetVersion() (*types.Version, error) {
-	return version.GetVersion(c.client)
+type VersionAPI interface {
+	GetVersion(ctx context.Context) (*types.Version, error)
+}
+
+func (c *Client) GetVersion(ctx context.Context) (*types.Version, error) {
+	return version.GetVersion(ctx, c.client)
 }
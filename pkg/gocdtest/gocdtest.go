@@ -0,0 +1,209 @@
+// Package gocdtest runs a stateful, in-memory stand-in of a GoCD server for integration tests.
+//
+// It implements /api/version, /api/current_user and /api/admin/packages, checking the Accept
+// header version, authentication, ETag/If-Match preconditions and payload validation the
+// same way GoCD does, so code built on pkg/client can be exercised end-to-end:
+//
+//	srv := gocdtest.NewServer()
+//	defer srv.Close()
+//	c, err := client.NewClient(srv.URL)
+package gocdtest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const anonymous = "anonymous"
+
+type Server struct {
+	// URL is the base URL of the server, pass it to client.NewClient.
+	URL string
+
+	ts *httptest.Server
+
+	mu        sync.Mutex
+	version   types.Version
+	passwords map[string]string
+	tokens    map[string]string
+	packages  map[string]types.Package
+}
+
+func NewServer() *Server {
+	s := &Server{
+		version: types.Version{
+			Version:     "23.1.0",
+			BuildNumber: "16079",
+			GitSha:      "4d4c95b2d1e5b0ee2a4b1a3ce0b3e4d0a3d1b1a0",
+			FullVersion: "23.1.0 (16079-4d4c95b2d1e5b0ee2a4b1a3ce0b3e4d0a3d1b1a0)",
+			CommitURL:   "https://github.com/gocd/gocd/commits/4d4c95b2d1e5b0ee2a4b1a3ce0b3e4d0a3d1b1a0",
+		},
+		passwords: map[string]string{},
+		tokens:    map[string]string{},
+		packages:  map[string]types.Package{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/go/api/version", s.handleVersion)
+	mux.HandleFunc("/go/api/current_user", s.handleCurrentUser)
+	mux.HandleFunc("/go/api/admin/packages", s.handlePackages)
+	mux.HandleFunc("/go/api/admin/packages/", s.handlePackage)
+
+	s.ts = httptest.NewServer(mux)
+	s.URL = s.ts.URL + "/go"
+
+	return s
+}
+
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+func (s *Server) SetVersion(version types.Version) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.version = version
+}
+
+// AddUser enables authentication, from then on every request needs valid credentials.
+func (s *Server) AddUser(login, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.passwords[login] = password
+}
+
+// AddToken enables authentication and registers an access token for login.
+func (s *Server) AddToken(login, token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[token] = login
+}
+
+func (s *Server) AddPackage(pkg types.Package) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.packages[pkg.Id] = pkg
+}
+
+func (s *Server) Package(packageId string) (types.Package, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pkg, ok := s.packages[packageId]
+
+	return pkg, ok
+}
+
+// authenticate returns the login of the caller, "anonymous" when security is disabled.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.passwords) == 0 && len(s.tokens) == 0 {
+		return anonymous, true
+	}
+
+	if user, password, ok := r.BasicAuth(); ok {
+		if expected, found := s.passwords[user]; found && expected == password {
+			return user, true
+		}
+	}
+
+	const bearer = "Bearer "
+	if auth := r.Header.Get("Authorization"); len(auth) > len(bearer) && auth[:len(bearer)] == bearer {
+		if login, found := s.tokens[auth[len(bearer):]]; found {
+			return login, true
+		}
+	}
+
+	writeMessage(w, http.StatusUnauthorized, constants.AcceptV1, "You are not authenticated!")
+
+	return "", false
+}
+
+// accepts checks the API version requested in the Accept header, GoCD answers 406 otherwise.
+func accepts(w http.ResponseWriter, r *http.Request, accept string) bool {
+	if r.Header.Get("Accept") == accept {
+		return true
+	}
+
+	writeMessage(w, http.StatusNotAcceptable, accept, "The API version requested is not supported. Supported version: "+accept)
+
+	return false
+}
+
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if r.Method == method {
+			return true
+		}
+	}
+
+	writeMessage(w, http.StatusMethodNotAllowed, constants.AcceptV1, "Method not allowed")
+
+	return false
+}
+
+func eTag(v any) string {
+	body, _ := json.Marshal(v)
+	sum := sha256.Sum256(body)
+
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, accept string, v any) {
+	w.Header().Set("Content-Type", accept+"; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeMessage(w http.ResponseWriter, status int, accept, message string) {
+	writeJSON(w, status, accept, map[string]string{"message": message})
+}
+
+func writeValidationError(w http.ResponseWriter, accept string, data any) {
+	writeJSON(w, http.StatusUnprocessableEntity, accept, map[string]any{
+		"message": "Validation error.",
+		"data":    data,
+	})
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) || !accepts(w, r, constants.AcceptV1) {
+		return
+	}
+
+	s.mu.Lock()
+	version := s.version
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, constants.AcceptV1, version)
+}
+
+func (s *Server) handleCurrentUser(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) || !accepts(w, r, constants.AcceptV1) {
+		return
+	}
+
+	login, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, constants.AcceptV1, types.CurrentUser{
+		LoginName:   login,
+		DisplayName: login,
+		Enabled:     true,
+	})
+}
@@ -0,0 +1,122 @@
+package gocdtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/client"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPackage(id string) *types.Package {
+	return &types.Package{
+		Id:          id,
+		Name:        id,
+		PackageRepo: types.PackageRepo{Id: "repo-1", Name: "repo"},
+		Configuration: []types.Properties{
+			{Key: "PACKAGE_SPEC", Value: "foo*"},
+		},
+	}
+}
+
+func TestVersionAndCurrentUser(t *testing.T) {
+	t.Parallel()
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddToken("admin", "secret")
+
+	c, err := client.NewClient(srv.URL, client.WithAccessToken("secret"))
+	require.NoError(t, err)
+
+	version, err := c.GetVersion(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "23.1.0", version.Version)
+
+	user, err := c.GetCurrentUser(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "admin", user.LoginName)
+
+	anonymous, err := client.NewClient(srv.URL, client.WithBasicAuth("admin", "wrong"))
+	require.NoError(t, err)
+
+	_, err = anonymous.GetCurrentUser(context.TODO())
+	assert.True(t, client.IsUnauthorized(err))
+}
+
+func TestPackagesLifecycle(t *testing.T) {
+	t.Parallel()
+	srv := NewServer()
+	defer srv.Close()
+
+	c, err := client.NewClient(srv.URL)
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	created, err := c.CreatePackage(ctx, newPackage("pkg-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "pkg-1", created.Id)
+
+	_, err = c.CreatePackage(ctx, newPackage("pkg-1"))
+	require.True(t, client.IsUnprocessable(err))
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "Validation error.", apiErr.Message)
+	assert.Contains(t, apiErr.Data["errors"], "id")
+
+	pkg, eTag, err := c.GetPackageWithETag(ctx, "pkg-1")
+	require.NoError(t, err)
+
+	pkg.AutoUpdate = true
+	_, err = c.UpdatePackage(ctx, pkg, eTag)
+	require.NoError(t, err)
+
+	// the ETag is stale now
+	_, err = c.UpdatePackage(ctx, pkg, eTag)
+	assert.True(t, client.IsPreconditionFailed(err))
+
+	modified, err := c.ModifyPackage(ctx, "pkg-1", func(p *types.Package) error {
+		p.Name = "renamed"
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", modified.Name)
+
+	stored, ok := srv.Package("pkg-1")
+	require.True(t, ok)
+	assert.True(t, stored.AutoUpdate)
+	assert.Equal(t, "renamed", stored.Name)
+
+	all, err := c.GetAllPackages(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all.Embedded.Packages, 1)
+
+	msg, err := c.DeletePackage(ctx, "pkg-1")
+	require.NoError(t, err)
+	assert.Contains(t, msg, "deleted successfully")
+
+	_, err = c.GetPackage(ctx, "pkg-1")
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestAcceptVersion(t *testing.T) {
+	t.Parallel()
+	srv := NewServer()
+	defer srv.Close()
+
+	c, err := client.NewClient(srv.URL, client.WithMiddleware(client.Middleware{
+		BeforeRequest: func(req *http.Request) error {
+			req.Header.Set("Accept", "application/vnd.go.cd.v1+json")
+
+			return nil
+		},
+	}))
+	require.NoError(t, err)
+
+	_, err = c.GetAllPackages(context.TODO())
+	require.ErrorIs(t, err, client.ErrNotAcceptable)
+}
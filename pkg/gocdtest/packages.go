@@ -0,0 +1,189 @@
+package gocdtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlinScreciu/gocd-go-api-client/internal/constants"
+	"github.com/AlinScreciu/gocd-go-api-client/pkg/types"
+)
+
+const packagesPath = "/go/api/admin/packages"
+
+type packageErrors struct {
+	types.Package
+	Errors map[string][]string `json:"errors"`
+}
+
+func validatePackage(pkg types.Package) map[string][]string {
+	errs := map[string][]string{}
+
+	if pkg.Id == "" {
+		errs["id"] = []string{"Package id is mandatory"}
+	}
+
+	if pkg.Name == "" {
+		errs["name"] = []string{"Package name is mandatory"}
+	}
+
+	if pkg.PackageRepo.Id == "" {
+		errs["package_repo"] = []string{"Package repository is mandatory"}
+	}
+
+	return errs
+}
+
+func decodePackage(w http.ResponseWriter, r *http.Request) (types.Package, bool) {
+	var pkg types.Package
+
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		writeMessage(w, http.StatusUnsupportedMediaType, constants.AcceptV2, "You must specify a 'Content-Type' of 'application/json'")
+
+		return pkg, false
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&pkg)
+	if err != nil {
+		writeMessage(w, http.StatusBadRequest, constants.AcceptV2, "Error parsing the request body: "+err.Error())
+
+		return pkg, false
+	}
+
+	return pkg, true
+}
+
+func (s *Server) handlePackages(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPost) || !accepts(w, r, constants.AcceptV2) {
+		return
+	}
+
+	if _, ok := s.authenticate(w, r); !ok {
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		s.createPackage(w, r)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var all types.AllPackages
+	for _, pkg := range s.packages {
+		all.Embedded.Packages = append(all.Embedded.Packages, pkg)
+	}
+
+	sort.Slice(all.Embedded.Packages, func(i, j int) bool {
+		return all.Embedded.Packages[i].Id < all.Embedded.Packages[j].Id
+	})
+
+	writeJSON(w, http.StatusOK, constants.AcceptV2, all)
+}
+
+func (s *Server) createPackage(w http.ResponseWriter, r *http.Request) {
+	pkg, ok := decodePackage(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	errs := validatePackage(pkg)
+	if _, exists := s.packages[pkg.Id]; exists && pkg.Id != "" {
+		errs["id"] = []string{fmt.Sprintf("Duplicate unique value [%s] declared for package id", pkg.Id)}
+	}
+
+	if len(errs) > 0 {
+		writeValidationError(w, constants.AcceptV2, packageErrors{pkg, errs})
+
+		return
+	}
+
+	s.packages[pkg.Id] = pkg
+
+	w.Header().Set("ETag", eTag(pkg))
+	writeJSON(w, http.StatusOK, constants.AcceptV2, pkg)
+}
+
+func (s *Server) handlePackage(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPut, http.MethodDelete) || !accepts(w, r, constants.AcceptV2) {
+		return
+	}
+
+	if _, ok := s.authenticate(w, r); !ok {
+		return
+	}
+
+	packageId := strings.TrimPrefix(r.URL.Path, packagesPath+"/")
+
+	if r.Method == http.MethodPut {
+		s.updatePackage(w, r, packageId)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pkg, ok := s.packages[packageId]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, constants.AcceptV2, "Either the resource you requested was not found, or you are not authorized to perform this action.")
+
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		delete(s.packages, packageId)
+		writeMessage(w, http.StatusOK, constants.AcceptV2, fmt.Sprintf("The package definition '%s' was deleted successfully.", packageId))
+
+		return
+	}
+
+	w.Header().Set("ETag", eTag(pkg))
+	writeJSON(w, http.StatusOK, constants.AcceptV2, pkg)
+}
+
+func (s *Server) updatePackage(w http.ResponseWriter, r *http.Request, packageId string) {
+	pkg, ok := decodePackage(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.packages[packageId]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, constants.AcceptV2, "Either the resource you requested was not found, or you are not authorized to perform this action.")
+
+		return
+	}
+
+	if r.Header.Get("If-Match") != eTag(current) {
+		writeMessage(w, http.StatusPreconditionFailed, constants.AcceptV2, fmt.Sprintf("Someone has modified the configuration for package '%s'. Please update your copy of the config with the changes and try again.", packageId))
+
+		return
+	}
+
+	errs := validatePackage(pkg)
+	if pkg.Id != packageId {
+		errs["id"] = []string{"Renaming of package id is not supported by this API."}
+	}
+
+	if len(errs) > 0 {
+		writeValidationError(w, constants.AcceptV2, packageErrors{pkg, errs})
+
+		return
+	}
+
+	s.packages[packageId] = pkg
+
+	w.Header().Set("ETag", eTag(pkg))
+	writeJSON(w, http.StatusOK, constants.AcceptV2, pkg)
+}
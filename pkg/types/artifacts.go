@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+)
+
+// Types of the entries of an artifact tree, see ArtifactNode.
+const (
+	ArtifactNodeFile   = "file"
+	ArtifactNodeFolder = "folder"
+)
+
+// ArtifactNode is an entry of the artifact tree of a job, only folders have Files.
+type ArtifactNode struct {
+	Name  string         `json:"name"`
+	URL   string         `json:"url"`
+	Type  string         `json:"type"`
+	Files []ArtifactNode `json:"files,omitempty"`
+}
+
+type ArtifactDownloadOptions struct {
+	// VerifyChecksum compares the MD5 of a downloaded file with the one GoCD recorded in
+	// cruise-output/md5.checksum, the download fails when it is missing or different.
+	VerifyChecksum bool
+	// PollInterval is how often a directory is asked for again while GoCD is still
+	// zipping it, 0 means 2 seconds.
+	PollInterval time.Duration
+}
@@ -0,0 +1,15 @@
+package types
+
+// Plugin ids of the config repo plugins bundled with GoCD.
+const (
+	ConfigRepoPluginYAML = "yaml.config.plugin"
+	ConfigRepoPluginJSON = "json.config.plugin"
+)
+
+// PipelineExport is a pipeline serialized by a config repo plugin, Filename is the name
+// the plugin suggests for it, e.g. "build.gocd.yaml".
+type PipelineExport struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
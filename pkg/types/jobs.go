@@ -0,0 +1,43 @@
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+type JobStateTransition struct {
+	Id              int64     `json:"id,omitempty"`
+	State           JobState  `json:"state"`
+	StateChangeTime Timestamp `json:"state_change_time"`
+}
+
+// ConsoleLogOptions tunes how the console log of a job is followed, the zero value polls
+// every 2 seconds from the start of the log.
+type ConsoleLogOptions struct {
+	PollInterval time.Duration
+	// Offset skips the first bytes of the log, e.g. to resume after a restart.
+	Offset int64
+}
+
+type JobHistory struct {
+	Links PageLinks     `json:"_links"`
+	Jobs  []JobInstance `json:"jobs"`
+}
+
+// TimeInState sums how long the job spent in each state. The current state of a job that
+// is still running is left out, its duration is not known yet.
+func (j *JobInstance) TimeInState() map[JobState]time.Duration {
+	transitions := append([]JobStateTransition(nil), j.StateTransitions...)
+	sort.SliceStable(transitions, func(a, b int) bool {
+		return transitions[a].StateChangeTime.Before(transitions[b].StateChangeTime.Time)
+	})
+
+	durations := make(map[JobState]time.Duration)
+
+	for i := 1; i < len(transitions); i++ {
+		previous := transitions[i-1]
+		durations[previous.State] += transitions[i].StateChangeTime.Sub(previous.StateChangeTime.Time)
+	}
+
+	return durations
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeInState(t *testing.T) {
+	t.Parallel()
+
+	start := time.UnixMilli(1667376000000)
+	at := func(d time.Duration) Timestamp {
+		return Timestamp{start.Add(d)}
+	}
+
+	job := &JobInstance{
+		StateTransitions: []JobStateTransition{
+			// out of order on purpose, the API does not promise any
+			{State: JobStateBuilding, StateChangeTime: at(40 * time.Second)},
+			{State: JobStateScheduled, StateChangeTime: at(0)},
+			{State: JobStateAssigned, StateChangeTime: at(30 * time.Second)},
+			{State: JobStatePreparing, StateChangeTime: at(31 * time.Second)},
+		},
+	}
+
+	assert.Equal(t, map[JobState]time.Duration{
+		JobStateScheduled: 30 * time.Second,
+		JobStateAssigned:  time.Second,
+		JobStatePreparing: 9 * time.Second,
+	}, job.TimeInState())
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+const (
+	MaterialTypeGit        = "git"
+	MaterialTypeSvn        = "svn"
+	MaterialTypeHg         = "hg"
+	MaterialTypeP4         = "p4"
+	MaterialTypeTfs        = "tfs"
+	MaterialTypeDependency = "dependency"
+	MaterialTypePackage    = "package"
+	MaterialTypePlugin     = "plugin"
+)
+
+// Material is one of the polymorphic GoCD materials, Attributes holds a *GitMaterial,
+// *SvnMaterial, *HgMaterial, *P4Material, *TfsMaterial, *DependencyMaterial,
+// *PackageMaterial, *PluginMaterial or, for unknown types, *RawAttributes.
+// Type may be left empty when building a material, it is derived from Attributes.
+type Material struct {
+	Type        string
+	Fingerprint string
+	Attributes  MaterialAttributes
+}
+
+type MaterialAttributes interface {
+	materialType() string
+}
+
+func (m Material) MarshalJSON() ([]byte, error) {
+	kind := m.Type
+	if kind == "" && m.Attributes != nil {
+		kind = m.Attributes.materialType()
+	}
+
+	return json.Marshal(struct {
+		Type        string             `json:"type"`
+		Fingerprint string             `json:"fingerprint,omitempty"`
+		Attributes  MaterialAttributes `json:"attributes"`
+	}{kind, m.Fingerprint, m.Attributes})
+}
+
+func (m *Material) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		polymorphic
+		Fingerprint string `json:"fingerprint"`
+	}
+
+	err := json.Unmarshal(data, &envelope)
+	if err != nil {
+		return err
+	}
+
+	m.Type = envelope.Type
+	m.Fingerprint = envelope.Fingerprint
+
+	switch envelope.Type {
+	case MaterialTypeGit:
+		m.Attributes, err = unmarshalAttributes[GitMaterial](envelope.Attributes)
+	case MaterialTypeSvn:
+		m.Attributes, err = unmarshalAttributes[SvnMaterial](envelope.Attributes)
+	case MaterialTypeHg:
+		m.Attributes, err = unmarshalAttributes[HgMaterial](envelope.Attributes)
+	case MaterialTypeP4:
+		m.Attributes, err = unmarshalAttributes[P4Material](envelope.Attributes)
+	case MaterialTypeTfs:
+		m.Attributes, err = unmarshalAttributes[TfsMaterial](envelope.Attributes)
+	case MaterialTypeDependency:
+		m.Attributes, err = unmarshalAttributes[DependencyMaterial](envelope.Attributes)
+	case MaterialTypePackage:
+		m.Attributes, err = unmarshalAttributes[PackageMaterial](envelope.Attributes)
+	case MaterialTypePlugin:
+		m.Attributes, err = unmarshalAttributes[PluginMaterial](envelope.Attributes)
+	default:
+		m.Attributes = &RawAttributes{Kind: envelope.Type, JSON: envelope.Attributes}
+	}
+
+	return err
+}
+
+type MaterialFilter struct {
+	Ignore   []string `json:"ignore,omitempty"`
+	Includes []string `json:"includes,omitempty"`
+}
+
+// ScmCommon holds the attributes shared by the source control materials.
+type ScmCommon struct {
+	Name              string          `json:"name,omitempty"`
+	Destination       string          `json:"destination,omitempty"`
+	Filter            *MaterialFilter `json:"filter,omitempty"`
+	InvertFilter      bool            `json:"invert_filter"`
+	AutoUpdate        bool            `json:"auto_update"`
+	Username          string          `json:"username,omitempty"`
+	Password          string          `json:"password,omitempty"`
+	EncryptedPassword string          `json:"encrypted_password,omitempty"`
+}
+
+type GitMaterial struct {
+	ScmCommon
+	URL             string `json:"url"`
+	Branch          string `json:"branch,omitempty"`
+	SubmoduleFolder string `json:"submodule_folder,omitempty"`
+	ShallowClone    bool   `json:"shallow_clone"`
+}
+
+func (*GitMaterial) materialType() string {
+	return MaterialTypeGit
+}
+
+type SvnMaterial struct {
+	ScmCommon
+	URL            string `json:"url"`
+	CheckExternals bool   `json:"check_externals"`
+}
+
+func (*SvnMaterial) materialType() string {
+	return MaterialTypeSvn
+}
+
+type HgMaterial struct {
+	ScmCommon
+	URL    string `json:"url"`
+	Branch string `json:"branch,omitempty"`
+}
+
+func (*HgMaterial) materialType() string {
+	return MaterialTypeHg
+}
+
+type P4Material struct {
+	ScmCommon
+	Port       string `json:"port"`
+	UseTickets bool   `json:"use_tickets"`
+	View       string `json:"view"`
+}
+
+func (*P4Material) materialType() string {
+	return MaterialTypeP4
+}
+
+type TfsMaterial struct {
+	ScmCommon
+	URL         string `json:"url"`
+	Domain      string `json:"domain,omitempty"`
+	ProjectPath string `json:"project_path"`
+}
+
+func (*TfsMaterial) materialType() string {
+	return MaterialTypeTfs
+}
+
+type DependencyMaterial struct {
+	Name                string `json:"name,omitempty"`
+	Pipeline            string `json:"pipeline"`
+	Stage               string `json:"stage"`
+	AutoUpdate          bool   `json:"auto_update"`
+	IgnoreForScheduling bool   `json:"ignore_for_scheduling"`
+}
+
+func (*DependencyMaterial) materialType() string {
+	return MaterialTypeDependency
+}
+
+// PackageMaterial references a package definition by its id.
+type PackageMaterial struct {
+	Ref string `json:"ref"`
+}
+
+func (*PackageMaterial) materialType() string {
+	return MaterialTypePackage
+}
+
+// PluginMaterial references a pluggable SCM by its id.
+type PluginMaterial struct {
+	Ref          string          `json:"ref"`
+	Destination  string          `json:"destination,omitempty"`
+	Filter       *MaterialFilter `json:"filter,omitempty"`
+	InvertFilter bool            `json:"invert_filter"`
+}
+
+func (*PluginMaterial) materialType() string {
+	return MaterialTypePlugin
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PipelineComparison lists the material changes between two instances of a pipeline.
+type PipelineComparison struct {
+	Links        Links            `json:"_links,omitempty"`
+	PipelineName string           `json:"pipeline_name"`
+	FromCounter  Counter          `json:"from_counter"`
+	ToCounter    Counter          `json:"to_counter"`
+	IsBisect     bool             `json:"is_bisect"`
+	Changes      []MaterialChange `json:"changes"`
+}
+
+type Commit struct {
+	Revision   string    `json:"revision_sha"`
+	ModifiedBy string    `json:"modified_by"`
+	ModifiedAt time.Time `json:"modified_at"`
+	Message    string    `json:"commit_message"`
+}
+
+type DependencyRevision struct {
+	Revision        string    `json:"revision"`
+	PipelineCounter Counter   `json:"pipeline_counter"`
+	CompletedAt     time.Time `json:"completed_at"`
+}
+
+type PackageRevision struct {
+	Revision   string    `json:"revision"`
+	ModifiedBy string    `json:"modified_by,omitempty"`
+	ModifiedAt time.Time `json:"modified_at"`
+	Comment    string    `json:"comment,omitempty"`
+}
+
+// MaterialChange holds the revisions of a material between the compared instances, only
+// the slice matching the material type is set: Commits for source control and pluggable
+// SCM materials, DependencyRevisions for upstream pipelines and PackageRevisions for
+// packages.
+type MaterialChange struct {
+	Material            Material
+	Commits             []Commit
+	DependencyRevisions []DependencyRevision
+	PackageRevisions    []PackageRevision
+}
+
+func (c *MaterialChange) UnmarshalJSON(data []byte) error {
+	var envelope struct {
+		Material Material        `json:"material"`
+		Revision json.RawMessage `json:"revision"`
+	}
+
+	err := json.Unmarshal(data, &envelope)
+	if err != nil {
+		return err
+	}
+
+	*c = MaterialChange{Material: envelope.Material}
+
+	if len(envelope.Revision) == 0 || string(envelope.Revision) == "null" {
+		return nil
+	}
+
+	switch envelope.Material.Type {
+	case MaterialTypeDependency:
+		return json.Unmarshal(envelope.Revision, &c.DependencyRevisions)
+	case MaterialTypePackage:
+		return json.Unmarshal(envelope.Revision, &c.PackageRevisions)
+	default:
+		return json.Unmarshal(envelope.Revision, &c.Commits)
+	}
+}
+
+func (c MaterialChange) MarshalJSON() ([]byte, error) {
+	var revision any
+
+	switch c.Material.Type {
+	case MaterialTypeDependency:
+		revision = c.DependencyRevisions
+	case MaterialTypePackage:
+		revision = c.PackageRevisions
+	default:
+		revision = c.Commits
+	}
+
+	return json.Marshal(struct {
+		Material Material `json:"material"`
+		Revision any      `json:"revision"`
+	}{c.Material, revision})
+}
+
+// describeMaterial names the material in a changelog heading, e.g. "app: git https://...".
+func describeMaterial(m Material) string {
+	var name, target string
+
+	switch attributes := m.Attributes.(type) {
+	case *GitMaterial:
+		name, target = attributes.Name, attributes.URL
+		if attributes.Branch != "" {
+			target += " (" + attributes.Branch + ")"
+		}
+	case *SvnMaterial:
+		name, target = attributes.Name, attributes.URL
+	case *HgMaterial:
+		name, target = attributes.Name, attributes.URL
+	case *P4Material:
+		name, target = attributes.Name, attributes.Port+" "+attributes.View
+	case *TfsMaterial:
+		name, target = attributes.Name, attributes.URL+" "+attributes.ProjectPath
+	case *DependencyMaterial:
+		name, target = attributes.Name, attributes.Pipeline+"/"+attributes.Stage
+	case *PackageMaterial:
+		target = attributes.Ref
+	case *PluginMaterial:
+		target = attributes.Ref
+	}
+
+	description := strings.TrimSpace(m.Type + " " + target)
+	if name != "" {
+		description = name + ": " + description
+	}
+
+	return description
+}
+
+// firstLine keeps the summary of a commit message, Markdown list items are single lines.
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+
+	return strings.TrimSpace(line)
+}
+
+func shortRevision(revision string) string {
+	if len(revision) > 12 {
+		return revision[:12]
+	}
+
+	return revision
+}
+
+// Markdown renders the comparison as a changelog, one section per material with one item
+// per revision, newest first as GoCD returns them.
+func (p *PipelineComparison) Markdown() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %s %d...%d\n", p.PipelineName, p.FromCounter, p.ToCounter)
+
+	if len(p.Changes) == 0 {
+		sb.WriteString("\nNo changes.\n")
+
+		return sb.String()
+	}
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&sb, "\n### %s\n\n", describeMaterial(change.Material))
+
+		for _, commit := range change.Commits {
+			fmt.Fprintf(&sb, "- `%s` %s", shortRevision(commit.Revision), firstLine(commit.Message))
+
+			if commit.ModifiedBy != "" {
+				fmt.Fprintf(&sb, " (%s)", commit.ModifiedBy)
+			}
+
+			sb.WriteString("\n")
+		}
+
+		for _, revision := range change.DependencyRevisions {
+			fmt.Fprintf(&sb, "- `%s`", revision.Revision)
+
+			if !revision.CompletedAt.IsZero() {
+				fmt.Fprintf(&sb, " completed %s", revision.CompletedAt.UTC().Format(time.RFC3339))
+			}
+
+			sb.WriteString("\n")
+		}
+
+		for _, revision := range change.PackageRevisions {
+			fmt.Fprintf(&sb, "- `%s`", revision.Revision)
+
+			if comment := firstLine(revision.Comment); comment != "" {
+				sb.WriteString(" " + comment)
+			}
+
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
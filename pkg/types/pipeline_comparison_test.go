@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const comparisonJSON = `{
+  "pipeline_name": "deploy",
+  "from_counter": 12,
+  "to_counter": 15,
+  "is_bisect": false,
+  "changes": [
+    {
+      "material": {"type": "git", "attributes": {"url": "https://example.com/app.git", "branch": "main", "name": "app", "auto_update": true}},
+      "revision": [
+        {"revision_sha": "4f1c2d3e5a6b7c8d9e0f", "modified_by": "alice <alice@example.com>", "modified_at": "2022-11-02T09:00:00Z", "commit_message": "Fix login\n\nLong description"},
+        {"revision_sha": "0a1b2c", "modified_by": "bob", "modified_at": "2022-11-02T08:00:00Z", "commit_message": "Add logout"}
+      ]
+    },
+    {
+      "material": {"type": "dependency", "attributes": {"pipeline": "build", "stage": "package", "auto_update": true}},
+      "revision": [{"revision": "build/42/package/1", "pipeline_counter": "42", "completed_at": "2022-11-02T09:30:00Z"}]
+    },
+    {
+      "material": {"type": "package", "attributes": {"ref": "libfoo"}},
+      "revision": [{"revision": "libfoo-1.2.3", "modified_at": "2022-11-01T00:00:00Z", "comment": "Release 1.2.3"}]
+    }
+  ]
+}`
+
+func TestPipelineComparison(t *testing.T) {
+	t.Parallel()
+
+	var comparison PipelineComparison
+	require.NoError(t, json.Unmarshal([]byte(comparisonJSON), &comparison))
+
+	require.Len(t, comparison.Changes, 3)
+	assert.Len(t, comparison.Changes[0].Commits, 2)
+	assert.Equal(t, Counter(42), comparison.Changes[1].DependencyRevisions[0].PipelineCounter)
+	assert.Equal(t, "libfoo-1.2.3", comparison.Changes[2].PackageRevisions[0].Revision)
+
+	assert.Equal(t, "## deploy 12...15\n"+
+		"\n### app: git https://example.com/app.git (main)\n\n"+
+		"- `4f1c2d3e5a6b` Fix login (alice <alice@example.com>)\n"+
+		"- `0a1b2c` Add logout (bob)\n"+
+		"\n### dependency build/package\n\n"+
+		"- `build/42/package/1` completed 2022-11-02T09:30:00Z\n"+
+		"\n### package libfoo\n\n"+
+		"- `libfoo-1.2.3` Release 1.2.3\n", comparison.Markdown())
+
+	body, err := json.Marshal(comparison.Changes[1])
+	require.NoError(t, err)
+
+	var roundTrip MaterialChange
+	require.NoError(t, json.Unmarshal(body, &roundTrip))
+	assert.Equal(t, comparison.Changes[1], roundTrip)
+}
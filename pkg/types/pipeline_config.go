@@ -0,0 +1,247 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type PipelineConfig struct {
+	Links                Links                 `json:"_links,omitempty"`
+	Group                string                `json:"group,omitempty"`
+	Name                 string                `json:"name"`
+	LabelTemplate        string                `json:"label_template,omitempty"`
+	LockBehavior         string                `json:"lock_behavior,omitempty"`
+	DisplayOrderWeight   int                   `json:"display_order_weight,omitempty"`
+	Template             string                `json:"template,omitempty"`
+	Origin               *Origin               `json:"origin,omitempty"`
+	Parameters           []Parameter           `json:"parameters"`
+	EnvironmentVariables []EnvironmentVariable `json:"environment_variables"`
+	Materials            []Material            `json:"materials"`
+	Stages               []StageConfig         `json:"stages,omitempty"`
+	TrackingTool         *TrackingTool         `json:"tracking_tool,omitempty"`
+	Timer                *Timer                `json:"timer,omitempty"`
+}
+
+const (
+	LockBehaviorLockOnFailure      = "lockOnFailure"
+	LockBehaviorUnlockWhenFinished = "unlockWhenFinished"
+	LockBehaviorNone               = "none"
+)
+
+// Origin tells whether a pipeline is defined in the GoCD config ("gocd") or in a config repo.
+type Origin struct {
+	Type string `json:"type"`
+	Id   string `json:"id,omitempty"`
+}
+
+type Parameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// EnvironmentVariable holds either a plain Value or, when Secure, a Value to encrypt or
+// the EncryptedValue returned by the server.
+type EnvironmentVariable struct {
+	Name           string `json:"name"`
+	Value          string `json:"value,omitempty"`
+	EncryptedValue string `json:"encrypted_value,omitempty"`
+	Secure         bool   `json:"secure"`
+}
+
+type TrackingTool struct {
+	Type       string `json:"type"`
+	Attributes struct {
+		URLPattern string `json:"url_pattern"`
+		Regex      string `json:"regex"`
+	} `json:"attributes"`
+}
+
+type Timer struct {
+	Spec          string `json:"spec"`
+	OnlyOnChanges bool   `json:"only_on_changes"`
+}
+
+type StageConfig struct {
+	Name                  string                `json:"name"`
+	FetchMaterials        bool                  `json:"fetch_materials"`
+	CleanWorkingDirectory bool                  `json:"clean_working_directory"`
+	NeverCleanupArtifacts bool                  `json:"never_cleanup_artifacts"`
+	Approval              *Approval             `json:"approval,omitempty"`
+	EnvironmentVariables  []EnvironmentVariable `json:"environment_variables"`
+	Jobs                  []JobConfig           `json:"jobs"`
+}
+
+const (
+	ApprovalTypeSuccess = "success"
+	ApprovalTypeManual  = "manual"
+)
+
+type Approval struct {
+	Type               string                `json:"type"`
+	AllowOnlyOnSuccess bool                  `json:"allow_only_on_success"`
+	Authorization      ApprovalAuthorization `json:"authorization"`
+}
+
+type ApprovalAuthorization struct {
+	Roles []string `json:"roles"`
+	Users []string `json:"users"`
+}
+
+type JobConfig struct {
+	Name                 string                `json:"name"`
+	RunInstanceCount     RunInstanceCount      `json:"run_instance_count"`
+	Timeout              JobTimeout            `json:"timeout"`
+	ElasticProfileId     string                `json:"elastic_profile_id,omitempty"`
+	EnvironmentVariables []EnvironmentVariable `json:"environment_variables"`
+	Resources            []string              `json:"resources"`
+	Tasks                []Task                `json:"tasks"`
+	Tabs                 []Tab                 `json:"tabs"`
+	Artifacts            []Artifact            `json:"artifacts"`
+}
+
+// RunInstanceCount is null for a single instance, a number, or "all" to run on every agent.
+type RunInstanceCount struct {
+	All   bool
+	Count int
+}
+
+func (r RunInstanceCount) MarshalJSON() ([]byte, error) {
+	switch {
+	case r.All:
+		return []byte(`"all"`), nil
+	case r.Count > 0:
+		return json.Marshal(r.Count)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+func (r *RunInstanceCount) UnmarshalJSON(data []byte) error {
+	*r = RunInstanceCount{}
+
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		if s == "all" {
+			r.All = true
+
+			return nil
+		}
+
+		data = []byte(s)
+	}
+
+	if string(data) == "null" || len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, &r.Count)
+}
+
+// JobTimeout is null to use the server default, "never", or a number of minutes.
+type JobTimeout struct {
+	Never   bool
+	Minutes int
+}
+
+func (t JobTimeout) MarshalJSON() ([]byte, error) {
+	switch {
+	case t.Never:
+		return []byte(`"never"`), nil
+	case t.Minutes > 0:
+		return json.Marshal(t.Minutes)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+func (t *JobTimeout) UnmarshalJSON(data []byte) error {
+	*t = JobTimeout{}
+
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		if s == "never" {
+			t.Never = true
+
+			return nil
+		}
+
+		data = []byte(s)
+	}
+
+	if string(data) == "null" || len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, &t.Minutes)
+}
+
+type Tab struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+const (
+	ArtifactTypeBuild    = "build"
+	ArtifactTypeTest     = "test"
+	ArtifactTypeExternal = "external"
+)
+
+// Artifact uses Source and Destination for build and test artifacts, ArtifactId, StoreId and
+// Configuration for external ones.
+type Artifact struct {
+	Type          string       `json:"type"`
+	Source        string       `json:"source,omitempty"`
+	Destination   string       `json:"destination,omitempty"`
+	ArtifactId    string       `json:"artifact_id,omitempty"`
+	StoreId       string       `json:"store_id,omitempty"`
+	Configuration []Properties `json:"configuration,omitempty"`
+}
+
+// polymorphic decodes the {"type": ..., "attributes": {...}} envelope shared by tasks and materials.
+type polymorphic struct {
+	Type       string          `json:"type"`
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+func unmarshalAttributes[T any](data json.RawMessage) (*T, error) {
+	var t T
+
+	if len(data) == 0 || string(data) == "null" {
+		return &t, nil
+	}
+
+	err := json.Unmarshal(data, &t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func marshalPolymorphic(kind string, attributes any) ([]byte, error) {
+	return json.Marshal(struct {
+		Type       string `json:"type"`
+		Attributes any    `json:"attributes"`
+	}{kind, attributes})
+}
+
+// RawAttributes keeps the attributes of a task or material type this package does not model.
+type RawAttributes struct {
+	Kind string
+	JSON json.RawMessage
+}
+
+func (r *RawAttributes) MarshalJSON() ([]byte, error) {
+	if len(r.JSON) == 0 {
+		return []byte("null"), nil
+	}
+
+	return r.JSON, nil
+}
+
+func (r *RawAttributes) taskType() string {
+	return r.Kind
+}
+
+func (r *RawAttributes) materialType() string {
+	return r.Kind
+}
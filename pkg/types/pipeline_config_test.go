@@ -0,0 +1,175 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pipelineConfigJSON = `{
+  "label_template": "${COUNT}",
+  "lock_behavior": "lockOnFailure",
+  "name": "new_pipeline",
+  "template": null,
+  "group": "first",
+  "origin": {"type": "gocd"},
+  "parameters": [{"name": "env", "value": "qa"}],
+  "environment_variables": [
+    {"secure": false, "name": "PLAIN", "value": "plain"},
+    {"secure": true, "name": "SECRET", "encrypted_value": "aSdiFgRRZ6A="}
+  ],
+  "materials": [
+    {
+      "type": "git",
+      "fingerprint": "2f7c1e5b",
+      "attributes": {
+        "url": "git@github.com:sample_repo/example.git",
+        "destination": "dest",
+        "filter": {"ignore": ["**/*.html"]},
+        "invert_filter": false,
+        "name": null,
+        "auto_update": true,
+        "branch": "master",
+        "submodule_folder": null,
+        "shallow_clone": true
+      }
+    },
+    {"type": "dependency", "attributes": {"pipeline": "upstream", "stage": "build", "name": "upstream", "auto_update": true, "ignore_for_scheduling": false}},
+    {"type": "package", "attributes": {"ref": "pkg-1"}},
+    {"type": "plugin", "attributes": {"ref": "scm-1", "filter": null, "invert_filter": false, "destination": "scm"}},
+    {"type": "p4", "attributes": {"port": "p4:1666", "use_tickets": false, "view": "//depot/...", "auto_update": true, "invert_filter": false}},
+    {"type": "configrepo", "attributes": {"some":"thing"}}
+  ],
+  "stages": [
+    {
+      "name": "defaultStage",
+      "fetch_materials": true,
+      "clean_working_directory": false,
+      "never_cleanup_artifacts": false,
+      "approval": {
+        "type": "manual",
+        "allow_only_on_success": true,
+        "authorization": {"roles": ["admins"], "users": []}
+      },
+      "environment_variables": [],
+      "jobs": [
+        {
+          "name": "defaultJob",
+          "run_instance_count": "all",
+          "timeout": "never",
+          "environment_variables": [],
+          "resources": ["linux"],
+          "tasks": [
+            {
+              "type": "exec",
+              "attributes": {
+                "run_if": ["passed"],
+                "on_cancel": {"type": "exec", "attributes": {"run_if": [], "command": "cleanup"}},
+                "command": "make",
+                "arguments": ["build"],
+                "working_directory": "src"
+              }
+            },
+            {"type": "ant", "attributes": {"run_if": ["any"], "build_file": "build.xml", "target": "dist"}},
+            {"type": "nant", "attributes": {"run_if": ["passed"], "nant_path": "C:\\nant", "target": "all"}},
+            {"type": "rake", "attributes": {"run_if": ["failed"], "target": "spec"}},
+            {
+              "type": "fetch",
+              "attributes": {
+                "artifact_origin": "gocd",
+                "run_if": ["passed"],
+                "pipeline": "upstream",
+                "stage": "build",
+                "job": "compile",
+                "source": "bin/app",
+                "is_source_a_file": true,
+                "destination": "bin"
+              }
+            },
+            {
+              "type": "pluggable_task",
+              "attributes": {
+                "run_if": ["passed"],
+                "plugin_configuration": {"id": "script-executor", "version": "1"},
+                "configuration": [{"key": "script", "value": "echo hi"}]
+              }
+            }
+          ],
+          "tabs": [{"name": "coverage", "path": "coverage/index.html"}],
+          "artifacts": [
+            {"type": "build", "source": "bin", "destination": "out"},
+            {"type": "external", "artifact_id": "docker", "store_id": "dockerhub", "configuration": [{"key": "Image", "value": "app"}]}
+          ]
+        },
+        {"name": "single", "run_instance_count": 3, "timeout": 15, "environment_variables": [], "resources": [], "tasks": [], "tabs": [], "artifacts": []},
+        {"name": "defaults", "run_instance_count": null, "timeout": null, "environment_variables": [], "resources": [], "tasks": [], "tabs": [], "artifacts": []}
+      ]
+    }
+  ],
+  "tracking_tool": {"type": "generic", "attributes": {"url_pattern": "https://jira/${ID}", "regex": "##(\\d+)"}},
+  "timer": {"spec": "0 0 22 ? * MON-FRI", "only_on_changes": true}
+}`
+
+func TestPipelineConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var pipeline PipelineConfig
+	require.NoError(t, json.Unmarshal([]byte(pipelineConfigJSON), &pipeline))
+
+	require.Len(t, pipeline.Materials, 6)
+	git, ok := pipeline.Materials[0].Attributes.(*GitMaterial)
+	require.True(t, ok)
+	assert.Equal(t, "master", git.Branch)
+	assert.Equal(t, []string{"**/*.html"}, git.Filter.Ignore)
+	assert.Equal(t, "2f7c1e5b", pipeline.Materials[0].Fingerprint)
+	assert.IsType(t, &DependencyMaterial{}, pipeline.Materials[1].Attributes)
+	assert.IsType(t, &PackageMaterial{}, pipeline.Materials[2].Attributes)
+	assert.IsType(t, &PluginMaterial{}, pipeline.Materials[3].Attributes)
+	assert.IsType(t, &P4Material{}, pipeline.Materials[4].Attributes)
+	assert.IsType(t, &RawAttributes{}, pipeline.Materials[5].Attributes)
+
+	assert.True(t, pipeline.EnvironmentVariables[1].Secure)
+	assert.Equal(t, "aSdiFgRRZ6A=", pipeline.EnvironmentVariables[1].EncryptedValue)
+
+	stage := pipeline.Stages[0]
+	assert.Equal(t, ApprovalTypeManual, stage.Approval.Type)
+
+	job := stage.Jobs[0]
+	assert.True(t, job.RunInstanceCount.All)
+	assert.True(t, job.Timeout.Never)
+	assert.Equal(t, RunInstanceCount{Count: 3}, stage.Jobs[1].RunInstanceCount)
+	assert.Equal(t, JobTimeout{Minutes: 15}, stage.Jobs[1].Timeout)
+	assert.Equal(t, RunInstanceCount{}, stage.Jobs[2].RunInstanceCount)
+
+	require.Len(t, job.Tasks, 6)
+	exec, ok := job.Tasks[0].Attributes.(*ExecTask)
+	require.True(t, ok)
+	assert.Equal(t, "make", exec.Command)
+	assert.Equal(t, "cleanup", exec.OnCancel.Attributes.(*ExecTask).Command)
+	assert.IsType(t, &AntTask{}, job.Tasks[1].Attributes)
+	assert.IsType(t, &NantTask{}, job.Tasks[2].Attributes)
+	assert.IsType(t, &RakeTask{}, job.Tasks[3].Attributes)
+	assert.True(t, job.Tasks[4].Attributes.(*FetchTask).IsSourceAFile)
+	assert.Equal(t, "script-executor", job.Tasks[5].Attributes.(*PluggableTask).PluginConfiguration.Id)
+
+	encoded, err := json.Marshal(pipeline)
+	require.NoError(t, err)
+
+	var decoded PipelineConfig
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, pipeline, decoded)
+}
+
+func TestTaskTypeFromAttributes(t *testing.T) {
+	t.Parallel()
+
+	encoded, err := json.Marshal(Task{Attributes: &ExecTask{Command: "ls"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "exec", "attributes": {"run_if": null, "command": "ls"}}`, string(encoded))
+
+	encoded, err = json.Marshal(Material{Attributes: &DependencyMaterial{Pipeline: "up", Stage: "build"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "dependency", "attributes": {"pipeline": "up", "stage": "build", "auto_update": false, "ignore_for_scheduling": false}}`, string(encoded))
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+type PipelineGroupPermission string
+
+const (
+	PipelineGroupView    PipelineGroupPermission = "view"
+	PipelineGroupOperate PipelineGroupPermission = "operate"
+	PipelineGroupAdmins  PipelineGroupPermission = "admins"
+)
+
+type PermissionTargets struct {
+	Users []string `json:"users"`
+	Roles []string `json:"roles"`
+}
+
+// PipelineGroupAuthorization is open to every user when no permission is set, granting a
+// single one restricts the group to the listed users and roles.
+type PipelineGroupAuthorization struct {
+	View    *PermissionTargets `json:"view,omitempty"`
+	Operate *PermissionTargets `json:"operate,omitempty"`
+	Admins  *PermissionTargets `json:"admins,omitempty"`
+}
+
+type PipelineGroupPipeline struct {
+	Links Links  `json:"_links,omitempty"`
+	Name  string `json:"name"`
+}
+
+type PipelineGroup struct {
+	Links         Links                       `json:"_links,omitempty"`
+	Name          string                      `json:"name"`
+	Authorization *PipelineGroupAuthorization `json:"authorization,omitempty"`
+	Pipelines     []PipelineGroupPipeline     `json:"pipelines,omitempty"`
+}
+
+type AllPipelineGroups struct {
+	Links    Links `json:"_links,omitempty"`
+	Embedded struct {
+		Groups []PipelineGroup `json:"groups,omitempty"`
+	} `json:"_embedded"`
+}
+
+func (a *PipelineGroupAuthorization) targets(permission PipelineGroupPermission) (**PermissionTargets, error) {
+	switch permission {
+	case PipelineGroupView:
+		return &a.View, nil
+	case PipelineGroupOperate:
+		return &a.Operate, nil
+	case PipelineGroupAdmins:
+		return &a.Admins, nil
+	default:
+		return nil, fmt.Errorf("unknown pipeline group permission '%s'", permission)
+	}
+}
+
+// GrantRole adds role to the permission of the group, it is a no-op when already granted.
+func (g *PipelineGroup) GrantRole(permission PipelineGroupPermission, role string) error {
+	if g.Authorization == nil {
+		g.Authorization = &PipelineGroupAuthorization{}
+	}
+
+	targets, err := g.Authorization.targets(permission)
+	if err != nil {
+		return err
+	}
+
+	if *targets == nil {
+		*targets = &PermissionTargets{Users: []string{}, Roles: []string{}}
+	}
+
+	if !slices.Contains((*targets).Roles, role) {
+		(*targets).Roles = append((*targets).Roles, role)
+	}
+
+	return nil
+}
+
+// RevokeRole removes role from the permission of the group, the users and other roles
+// granted the permission are kept.
+func (g *PipelineGroup) RevokeRole(permission PipelineGroupPermission, role string) error {
+	if g.Authorization == nil {
+		g.Authorization = &PipelineGroupAuthorization{}
+	}
+
+	targets, err := g.Authorization.targets(permission)
+	if err != nil || *targets == nil {
+		return err
+	}
+
+	(*targets).Roles = slices.DeleteFunc((*targets).Roles, func(r string) bool {
+		return r == role
+	})
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineGroupRoles(t *testing.T) {
+	t.Parallel()
+
+	group := &PipelineGroup{Name: "team-a"}
+
+	require.NoError(t, group.GrantRole(PipelineGroupOperate, "deployers"))
+	require.NoError(t, group.GrantRole(PipelineGroupOperate, "deployers"))
+	require.NoError(t, group.GrantRole(PipelineGroupOperate, "qa"))
+	require.NoError(t, group.RevokeRole(PipelineGroupOperate, "qa"))
+	require.NoError(t, group.RevokeRole(PipelineGroupAdmins, "qa"))
+	require.Error(t, group.GrantRole("owner", "qa"))
+
+	body, err := json.Marshal(group)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"_links": {"self": {"href": ""}, "doc": {"href": ""}},
+		"name": "team-a",
+		"authorization": {"operate": {"users": [], "roles": ["deployers"]}}
+	}`, string(body))
+}
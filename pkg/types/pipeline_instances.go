@@ -0,0 +1,89 @@
+package types
+
+type PipelineInstance struct {
+	Name                string          `json:"name"`
+	Counter             int             `json:"counter"`
+	Label               string          `json:"label"`
+	NaturalOrder        float64         `json:"natural_order"`
+	CanRun              bool            `json:"can_run"`
+	PreparingToSchedule bool            `json:"preparing_to_schedule"`
+	Comment             string          `json:"comment,omitempty"`
+	ScheduledDate       Timestamp       `json:"scheduled_date"`
+	BuildCause          BuildCause      `json:"build_cause"`
+	Stages              []StageInstance `json:"stages"`
+}
+
+type BuildCause struct {
+	TriggerMessage    string             `json:"trigger_message"`
+	TriggerForced     bool               `json:"trigger_forced"`
+	Approver          string             `json:"approver"`
+	MaterialRevisions []MaterialRevision `json:"material_revisions"`
+}
+
+type MaterialRevision struct {
+	Changed       bool            `json:"changed"`
+	Material      MaterialSummary `json:"material"`
+	Modifications []Modification  `json:"modifications"`
+}
+
+// MaterialSummary identifies the material of a revision, Type is e.g. "Git" or "Pipeline".
+type MaterialSummary struct {
+	Id          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Fingerprint string `json:"fingerprint"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type Modification struct {
+	Id           int64     `json:"id,omitempty"`
+	Revision     string    `json:"revision"`
+	ModifiedTime Timestamp `json:"modified_time"`
+	UserName     string    `json:"user_name"`
+	Comment      string    `json:"comment"`
+	EmailAddress string    `json:"email_address,omitempty"`
+}
+
+// StageInstance is a run of a stage, the pipeline fields are only set by the stages API.
+type StageInstance struct {
+	Id                    int64         `json:"id,omitempty"`
+	Name                  string        `json:"name"`
+	Counter               Counter       `json:"counter"`
+	PipelineName          string        `json:"pipeline_name,omitempty"`
+	PipelineCounter       int           `json:"pipeline_counter,omitempty"`
+	Scheduled             bool          `json:"scheduled"`
+	ApprovalType          string        `json:"approval_type"`
+	ApprovedBy            string        `json:"approved_by"`
+	Result                StageResult   `json:"result,omitempty"`
+	Status                StageState    `json:"status,omitempty"`
+	RerunOfCounter        *Counter      `json:"rerun_of_counter"`
+	FetchMaterials        bool          `json:"fetch_materials,omitempty"`
+	CleanWorkingDirectory bool          `json:"clean_working_directory,omitempty"`
+	ArtifactsDeleted      bool          `json:"artifacts_deleted,omitempty"`
+	OperatePermission     bool          `json:"operate_permission,omitempty"`
+	CanRun                bool          `json:"can_run,omitempty"`
+	Jobs                  []JobInstance `json:"jobs"`
+}
+
+// JobInstance is a run of a job, the history of pipelines and stages only fills in its
+// summary while the jobs API sets every field.
+type JobInstance struct {
+	Id               int64                `json:"id,omitempty"`
+	Name             string               `json:"name"`
+	ScheduledDate    Timestamp            `json:"scheduled_date"`
+	State            JobState             `json:"state"`
+	Result           JobResult            `json:"result"`
+	Rerun            bool                 `json:"rerun,omitempty"`
+	OriginalJobId    *int64               `json:"original_job_id,omitempty"`
+	AgentUUID        string               `json:"agent_uuid,omitempty"`
+	PipelineName     string               `json:"pipeline_name,omitempty"`
+	PipelineCounter  int                  `json:"pipeline_counter,omitempty"`
+	StageName        string               `json:"stage_name,omitempty"`
+	StageCounter     Counter              `json:"stage_counter,omitempty"`
+	StateTransitions []JobStateTransition `json:"job_state_transitions,omitempty"`
+}
+
+type PipelineHistory struct {
+	Links     PageLinks          `json:"_links"`
+	Pipelines []PipelineInstance `json:"pipelines"`
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+)
+
+// ScheduleOptions overrides what a pipeline run is triggered with, the zero value schedules
+// it like the "Trigger" button does.
+type ScheduleOptions struct {
+	// EnvironmentVariables overrides variables for this run, set Secure for secret values.
+	EnvironmentVariables []EnvironmentVariable `json:"environment_variables,omitempty"`
+	// Materials pins materials, identified by fingerprint, to a revision.
+	Materials []MaterialRevisionOverride `json:"materials,omitempty"`
+	// UpdateMaterialsBeforeScheduling defaults to true on the server when nil.
+	UpdateMaterialsBeforeScheduling *bool `json:"update_materials_before_scheduling,omitempty"`
+}
+
+type MaterialRevisionOverride struct {
+	Fingerprint string `json:"fingerprint"`
+	Revision    string `json:"revision"`
+}
+
+// RunPipelineOptions controls how a pipeline run is scheduled and followed, the zero value
+// polls every 2 seconds, backing off to 30 seconds while nothing changes.
+type RunPipelineOptions struct {
+	Schedule        *ScheduleOptions
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+	// OnProgress is called with the instance each time a stage or job changes state.
+	OnProgress func(instance *PipelineInstance)
+}
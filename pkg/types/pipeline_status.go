@@ -0,0 +1,12 @@
+package types
+
+import "time"
+
+type PipelineStatus struct {
+	Paused      bool       `json:"paused"`
+	PausedCause string     `json:"paused_cause"`
+	PausedBy    string     `json:"paused_by"`
+	PausedAt    *time.Time `json:"paused_at,omitempty"`
+	Locked      bool       `json:"locked"`
+	Schedulable bool       `json:"schedulable"`
+}
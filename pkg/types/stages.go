@@ -0,0 +1,58 @@
+package types
+
+type StageResult string
+
+const (
+	StageResultPassed    StageResult = "Passed"
+	StageResultFailed    StageResult = "Failed"
+	StageResultCancelled StageResult = "Cancelled"
+	StageResultUnknown   StageResult = "Unknown"
+)
+
+// StageState is the status of a stage run, Failing means a job failed while others are
+// still building.
+type StageState string
+
+const (
+	StageStateBuilding  StageState = "Building"
+	StageStateFailing   StageState = "Failing"
+	StageStatePassed    StageState = "Passed"
+	StageStateFailed    StageState = "Failed"
+	StageStateCancelled StageState = "Cancelled"
+	StageStateUnknown   StageState = "Unknown"
+)
+
+// Done reports whether the stage stopped running.
+func (s StageState) Done() bool {
+	return s == StageStatePassed || s == StageStateFailed || s == StageStateCancelled
+}
+
+type JobResult string
+
+const (
+	JobResultPassed    JobResult = "Passed"
+	JobResultFailed    JobResult = "Failed"
+	JobResultCancelled JobResult = "Cancelled"
+	JobResultUnknown   JobResult = "Unknown"
+)
+
+type JobState string
+
+const (
+	JobStateScheduled    JobState = "Scheduled"
+	JobStateAssigned     JobState = "Assigned"
+	JobStatePreparing    JobState = "Preparing"
+	JobStateBuilding     JobState = "Building"
+	JobStateCompleting   JobState = "Completing"
+	JobStateCompleted    JobState = "Completed"
+	JobStateRescheduled  JobState = "Rescheduled"
+	JobStateDiscontinued JobState = "Discontinued"
+	JobStatePaused       JobState = "Paused"
+	JobStateWaiting      JobState = "Waiting"
+	JobStateUnknown      JobState = "Unknown"
+)
+
+type StageHistory struct {
+	Links  PageLinks       `json:"_links"`
+	Stages []StageInstance `json:"stages"`
+}
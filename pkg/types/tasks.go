@@ -0,0 +1,161 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+const (
+	TaskTypeExec      = "exec"
+	TaskTypeAnt       = "ant"
+	TaskTypeNant      = "nant"
+	TaskTypeRake      = "rake"
+	TaskTypeFetch     = "fetch"
+	TaskTypePluggable = "pluggable_task"
+)
+
+const (
+	RunIfPassed = "passed"
+	RunIfFailed = "failed"
+	RunIfAny    = "any"
+)
+
+// Task is one of the polymorphic GoCD tasks, Attributes holds an *ExecTask, *AntTask,
+// *NantTask, *RakeTask, *FetchTask, *PluggableTask or, for unknown types, *RawAttributes.
+// Type may be left empty when building a task, it is derived from Attributes.
+type Task struct {
+	Type       string
+	Attributes TaskAttributes
+}
+
+type TaskAttributes interface {
+	taskType() string
+}
+
+func (t Task) MarshalJSON() ([]byte, error) {
+	kind := t.Type
+	if kind == "" && t.Attributes != nil {
+		kind = t.Attributes.taskType()
+	}
+
+	return marshalPolymorphic(kind, t.Attributes)
+}
+
+func (t *Task) UnmarshalJSON(data []byte) error {
+	var envelope polymorphic
+
+	err := json.Unmarshal(data, &envelope)
+	if err != nil {
+		return err
+	}
+
+	t.Type = envelope.Type
+
+	switch envelope.Type {
+	case TaskTypeExec:
+		t.Attributes, err = unmarshalAttributes[ExecTask](envelope.Attributes)
+	case TaskTypeAnt:
+		t.Attributes, err = unmarshalAttributes[AntTask](envelope.Attributes)
+	case TaskTypeNant:
+		t.Attributes, err = unmarshalAttributes[NantTask](envelope.Attributes)
+	case TaskTypeRake:
+		t.Attributes, err = unmarshalAttributes[RakeTask](envelope.Attributes)
+	case TaskTypeFetch:
+		t.Attributes, err = unmarshalAttributes[FetchTask](envelope.Attributes)
+	case TaskTypePluggable:
+		t.Attributes, err = unmarshalAttributes[PluggableTask](envelope.Attributes)
+	default:
+		t.Attributes = &RawAttributes{Kind: envelope.Type, JSON: envelope.Attributes}
+	}
+
+	return err
+}
+
+// TaskCommon holds the attributes shared by every task type.
+type TaskCommon struct {
+	RunIf    []string `json:"run_if"`
+	OnCancel *Task    `json:"on_cancel,omitempty"`
+}
+
+type ExecTask struct {
+	TaskCommon
+	Command          string   `json:"command"`
+	Arguments        []string `json:"arguments,omitempty"`
+	WorkingDirectory string   `json:"working_directory,omitempty"`
+}
+
+func (*ExecTask) taskType() string {
+	return TaskTypeExec
+}
+
+type AntTask struct {
+	TaskCommon
+	BuildFile        string `json:"build_file,omitempty"`
+	Target           string `json:"target,omitempty"`
+	WorkingDirectory string `json:"working_directory,omitempty"`
+}
+
+func (*AntTask) taskType() string {
+	return TaskTypeAnt
+}
+
+type NantTask struct {
+	TaskCommon
+	BuildFile        string `json:"build_file,omitempty"`
+	Target           string `json:"target,omitempty"`
+	WorkingDirectory string `json:"working_directory,omitempty"`
+	NantPath         string `json:"nant_path,omitempty"`
+}
+
+func (*NantTask) taskType() string {
+	return TaskTypeNant
+}
+
+type RakeTask struct {
+	TaskCommon
+	BuildFile        string `json:"build_file,omitempty"`
+	Target           string `json:"target,omitempty"`
+	WorkingDirectory string `json:"working_directory,omitempty"`
+}
+
+func (*RakeTask) taskType() string {
+	return TaskTypeRake
+}
+
+const (
+	ArtifactOriginGoCD     = "gocd"
+	ArtifactOriginExternal = "external"
+)
+
+// FetchTask fetches Source from a "gocd" artifact origin, or ArtifactId with its
+// Configuration from an "external" one.
+type FetchTask struct {
+	TaskCommon
+	ArtifactOrigin string       `json:"artifact_origin"`
+	Pipeline       string       `json:"pipeline,omitempty"`
+	Stage          string       `json:"stage"`
+	Job            string       `json:"job"`
+	Source         string       `json:"source,omitempty"`
+	IsSourceAFile  bool         `json:"is_source_a_file,omitempty"`
+	Destination    string       `json:"destination,omitempty"`
+	ArtifactId     string       `json:"artifact_id,omitempty"`
+	Configuration  []Properties `json:"configuration,omitempty"`
+}
+
+func (*FetchTask) taskType() string {
+	return TaskTypeFetch
+}
+
+type PluginConfiguration struct {
+	Id      string `json:"id"`
+	Version string `json:"version"`
+}
+
+type PluggableTask struct {
+	TaskCommon
+	PluginConfiguration PluginConfiguration `json:"plugin_configuration"`
+	Configuration       []Properties        `json:"configuration"`
+}
+
+func (*PluggableTask) taskType() string {
+	return TaskTypePluggable
+}
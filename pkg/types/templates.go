@@ -0,0 +1,53 @@
+package types
+
+// TemplateConfig is a pipeline template, its stages are typed like the ones of a
+// PipelineConfig. The parameters of a template are derived by GoCD from the #{param}
+// references of its stages and are not part of the body.
+type TemplateConfig struct {
+	Links  Links         `json:"_links,omitempty"`
+	Name   string        `json:"name"`
+	Stages []StageConfig `json:"stages"`
+}
+
+type TemplatePipeline struct {
+	Links Links  `json:"_links,omitempty"`
+	Name  string `json:"name"`
+}
+
+// TemplateSummary is a template as listed by GetAllTemplates, along with the pipelines
+// built from it.
+type TemplateSummary struct {
+	Links         Links  `json:"_links,omitempty"`
+	Name          string `json:"name"`
+	CanEdit       bool   `json:"can_edit"`
+	CanAdminister bool   `json:"can_administer"`
+	Embedded      struct {
+		Pipelines []TemplatePipeline `json:"pipelines,omitempty"`
+	} `json:"_embedded"`
+}
+
+// PipelineNames returns the names of the pipelines using the template.
+func (t *TemplateSummary) PipelineNames() []string {
+	names := make([]string, 0, len(t.Embedded.Pipelines))
+	for _, pipeline := range t.Embedded.Pipelines {
+		names = append(names, pipeline.Name)
+	}
+
+	return names
+}
+
+type AllTemplates struct {
+	Links    Links `json:"_links,omitempty"`
+	Embedded struct {
+		Templates []TemplateSummary `json:"templates,omitempty"`
+	} `json:"_embedded"`
+}
+
+// TemplateAuthorization lists who may administer and view a template, on top of the
+// admins of the groups of its pipelines when AllGroupAdminsAreViewUsers is set.
+type TemplateAuthorization struct {
+	Links                      Links              `json:"_links,omitempty"`
+	AllGroupAdminsAreViewUsers bool               `json:"all_group_admins_are_view_users"`
+	Admin                      *PermissionTargets `json:"admin,omitempty"`
+	View                       *PermissionTargets `json:"view,omitempty"`
+}
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
 type Links struct {
 	Self struct {
 		Href string `json:"href"`
@@ -54,3 +63,223 @@ type Version struct {
 	FullVersion string `json:"full_version,omitempty"`
 	CommitURL   string `json:"commit_url,omitempty"`
 }
+
+// Dashboard is what the GoCD dashboard shows to the current user, pipeline groups and
+// environments refer to the pipelines by name.
+type Dashboard struct {
+	Links           Links  `json:"_links,omitempty"`
+	Personalization string `json:"_personalization,omitempty"`
+	Embedded        struct {
+		PipelineGroups []DashboardGroup    `json:"pipeline_groups"`
+		Environments   []DashboardGroup    `json:"environments"`
+		Pipelines      []DashboardPipeline `json:"pipelines"`
+	} `json:"_embedded"`
+}
+
+// DashboardGroup is either a pipeline group or an environment.
+type DashboardGroup struct {
+	Links         Links    `json:"_links,omitempty"`
+	Name          string   `json:"name"`
+	Pipelines     []string `json:"pipelines"`
+	CanAdminister bool     `json:"can_administer"`
+}
+
+type DashboardPauseInfo struct {
+	Paused      bool       `json:"paused"`
+	PausedBy    string     `json:"paused_by"`
+	PauseReason string     `json:"pause_reason"`
+	PausedAt    *time.Time `json:"paused_at,omitempty"`
+}
+
+type DashboardPipeline struct {
+	Links                Links              `json:"_links,omitempty"`
+	Name                 string             `json:"name"`
+	LastUpdatedTimestamp Timestamp          `json:"last_updated_timestamp"`
+	Locked               bool               `json:"locked"`
+	PauseInfo            DashboardPauseInfo `json:"pause_info"`
+	CanAdminister        bool               `json:"can_administer"`
+	CanOperate           bool               `json:"can_operate"`
+	CanPause             bool               `json:"can_pause"`
+	CanUnlock            bool               `json:"can_unlock"`
+	CanSchedule          bool               `json:"can_schedule"`
+	FromConfigRepo       bool               `json:"from_config_repo"`
+	Embedded             struct {
+		Instances []DashboardInstance `json:"instances"`
+	} `json:"_embedded"`
+}
+
+type DashboardInstance struct {
+	Links       Links     `json:"_links,omitempty"`
+	Label       string    `json:"label"`
+	Counter     Counter   `json:"counter"`
+	TriggeredBy string    `json:"triggered_by"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	Embedded    struct {
+		Stages []DashboardStage `json:"stages"`
+	} `json:"_embedded"`
+}
+
+type DashboardStage struct {
+	Links       Links      `json:"_links,omitempty"`
+	Name        string     `json:"name"`
+	Counter     Counter    `json:"counter"`
+	Status      StageState `json:"status"`
+	ApprovedBy  string     `json:"approved_by"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+}
+
+func (d *Dashboard) pipelinesIn(groups []DashboardGroup, name string) []DashboardPipeline {
+	var names []string
+
+	for _, group := range groups {
+		if group.Name == name {
+			names = group.Pipelines
+
+			break
+		}
+	}
+
+	return d.Filter(func(p *DashboardPipeline) bool {
+		return slices.Contains(names, p.Name)
+	})
+}
+
+// Filter returns the pipelines keep reports true for, in dashboard order.
+func (d *Dashboard) Filter(keep func(*DashboardPipeline) bool) []DashboardPipeline {
+	var pipelines []DashboardPipeline
+
+	for i := range d.Embedded.Pipelines {
+		if keep(&d.Embedded.Pipelines[i]) {
+			pipelines = append(pipelines, d.Embedded.Pipelines[i])
+		}
+	}
+
+	return pipelines
+}
+
+func (d *Dashboard) PipelinesInGroup(group string) []DashboardPipeline {
+	return d.pipelinesIn(d.Embedded.PipelineGroups, group)
+}
+
+func (d *Dashboard) PipelinesInEnvironment(environment string) []DashboardPipeline {
+	return d.pipelinesIn(d.Embedded.Environments, environment)
+}
+
+func (d *Dashboard) FailingPipelines() []DashboardPipeline {
+	return d.Filter((*DashboardPipeline).Failing)
+}
+
+// LatestInstance returns the instance with the highest counter, nil for a pipeline that
+// never ran.
+func (p *DashboardPipeline) LatestInstance() *DashboardInstance {
+	var latest *DashboardInstance
+
+	for i := range p.Embedded.Instances {
+		if latest == nil || p.Embedded.Instances[i].Counter > latest.Counter {
+			latest = &p.Embedded.Instances[i]
+		}
+	}
+
+	return latest
+}
+
+// Failing reports whether a stage of the latest instance failed or is failing.
+func (p *DashboardPipeline) Failing() bool {
+	latest := p.LatestInstance()
+	if latest == nil {
+		return false
+	}
+
+	for _, stage := range latest.Embedded.Stages {
+		if stage.Status == StageStateFailed || stage.Status == StageStateFailing {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Link is a HAL link, e.g. the "next" page of a paginated collection.
+type Link struct {
+	Href string `json:"href"`
+}
+
+type PageLinks struct {
+	Next     *Link `json:"next,omitempty"`
+	Previous *Link `json:"previous,omitempty"`
+}
+
+// NextHref returns the href of the next page, empty on the last one.
+func (l PageLinks) NextHref() string {
+	if l.Next == nil {
+		return ""
+	}
+
+	return l.Next.Href
+}
+
+// PageOptions controls cursor pagination, After and Before are cursors taken from the
+// links of a previous page.
+type PageOptions struct {
+	// PageSize is the number of items fetched per request, 0 leaves it to the server,
+	// which sends 10. GoCD accepts 10 to 100, other sizes fail before any request.
+	PageSize int
+	After    string
+	Before   string
+	// Limit stops the iteration after this many items, 0 walks the whole history.
+	Limit int
+}
+
+// Timestamp is a point in time GoCD serializes as milliseconds since the epoch.
+type Timestamp struct {
+	time.Time
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.UnixMilli())
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.Time = time.Time{}
+
+		return nil
+	}
+
+	var millis int64
+
+	err := json.Unmarshal(data, &millis)
+	if err != nil {
+		return err
+	}
+
+	t.Time = time.UnixMilli(millis)
+
+	return nil
+}
+
+// Counter is a run counter, which GoCD serializes either as a number or as a string.
+type Counter int
+
+func (c *Counter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = 0
+
+		return nil
+	}
+
+	data = bytes.Trim(data, `"`)
+
+	n, err := strconv.Atoi(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid counter '%s': '%w'", string(data), err)
+	}
+
+	*c = Counter(n)
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// VSMNodeType is PIPELINE, DUMMY or the type of a material, e.g. GIT or PACKAGE.
+type VSMNodeType string
+
+const (
+	VSMNodePipeline VSMNodeType = "PIPELINE"
+	// VSMNodeDummy stands in for an edge crossing more than one level, it links the
+	// same parents and dependents as the edge it replaces.
+	VSMNodeDummy VSMNodeType = "DUMMY"
+)
+
+type VSMStage struct {
+	Name    string     `json:"name"`
+	Status  StageState `json:"status"`
+	Locator string     `json:"locator"`
+}
+
+type VSMPipelineInstance struct {
+	Counter Counter    `json:"counter"`
+	Label   string     `json:"label"`
+	Locator string     `json:"locator"`
+	Stages  []VSMStage `json:"stages"`
+}
+
+type VSMModification struct {
+	Revision     string `json:"revision"`
+	User         string `json:"user"`
+	Comment      string `json:"comment"`
+	ModifiedTime string `json:"modified_time"`
+	Locator      string `json:"locator"`
+}
+
+type VSMMaterialRevision struct {
+	Modifications []VSMModification `json:"modifications"`
+}
+
+// VSMNode is a pipeline, a material or a dummy node of a value stream map, it is linked
+// to the nodes of the previous and next levels by id.
+type VSMNode struct {
+	Id         string      `json:"id"`
+	Name       string      `json:"name"`
+	NodeType   VSMNodeType `json:"node_type"`
+	Parents    []string    `json:"parents"`
+	Dependents []string    `json:"dependents"`
+	Depth      int         `json:"depth"`
+	Locator    string      `json:"locator"`
+	// Level is the index of the level the node is in, set when decoding.
+	Level int `json:"-"`
+	// Instances are set on pipeline nodes.
+	Instances []VSMPipelineInstance `json:"instances,omitempty"`
+	// MaterialNames and MaterialRevisions are set on material nodes.
+	MaterialNames     []string              `json:"material_names,omitempty"`
+	MaterialRevisions []VSMMaterialRevision `json:"material_revisions,omitempty"`
+}
+
+func (n *VSMNode) IsPipeline() bool {
+	return n.NodeType == VSMNodePipeline
+}
+
+func (n *VSMNode) IsMaterial() bool {
+	return n.NodeType != VSMNodePipeline && n.NodeType != VSMNodeDummy
+}
+
+type VSMLevel struct {
+	Nodes []VSMNode `json:"nodes"`
+}
+
+// VSMEdge links a parent to one of its dependents.
+type VSMEdge struct {
+	From      string
+	To        string
+	FromLevel int
+	ToLevel   int
+}
+
+// ValueStreamMap is the graph of the materials and pipelines an instance of a pipeline, or
+// a revision of a material, flows through, from left to right by level.
+type ValueStreamMap struct {
+	// CurrentPipeline is the id of the node the map was requested for, CurrentMaterial is
+	// set instead for the map of a material revision.
+	CurrentPipeline string     `json:"current_pipeline,omitempty"`
+	CurrentMaterial string     `json:"current_material,omitempty"`
+	Levels          []VSMLevel `json:"levels"`
+	nodes           map[string]*VSMNode
+}
+
+func (m *ValueStreamMap) UnmarshalJSON(data []byte) error {
+	type plain ValueStreamMap
+
+	err := json.Unmarshal(data, (*plain)(m))
+	if err != nil {
+		return err
+	}
+
+	m.index()
+
+	return nil
+}
+
+func (m *ValueStreamMap) index() {
+	m.nodes = make(map[string]*VSMNode)
+
+	for level := range m.Levels {
+		for i := range m.Levels[level].Nodes {
+			node := &m.Levels[level].Nodes[i]
+			node.Level = level
+			m.nodes[node.Id] = node
+		}
+	}
+}
+
+// Node returns the node with the id, nil when there is none.
+func (m *ValueStreamMap) Node(id string) *VSMNode {
+	if m.nodes == nil {
+		m.index()
+	}
+
+	return m.nodes[id]
+}
+
+// Edges returns every parent to dependent link of the map, level by level.
+func (m *ValueStreamMap) Edges() []VSMEdge {
+	var edges []VSMEdge
+
+	for _, level := range m.Levels {
+		for _, node := range level.Nodes {
+			for _, dependent := range node.Dependents {
+				edge := VSMEdge{From: node.Id, To: dependent, FromLevel: node.Level, ToLevel: -1}
+				if to := m.Node(dependent); to != nil {
+					edge.ToLevel = to.Level
+				}
+
+				edges = append(edges, edge)
+			}
+		}
+	}
+
+	return edges
+}
+
+// walk visits the nodes reachable from id through next, nearest first, and returns the
+// ones keep reports true for. Dummy nodes are crossed but never returned.
+func (m *ValueStreamMap) walk(id string, next func(*VSMNode) []string, keep func(*VSMNode) bool) []*VSMNode {
+	start := m.Node(id)
+	if start == nil {
+		return nil
+	}
+
+	var found []*VSMNode
+
+	seen := map[string]bool{id: true}
+	queue := []*VSMNode{start}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, nextId := range next(node) {
+			if seen[nextId] {
+				continue
+			}
+
+			seen[nextId] = true
+
+			nextNode := m.Node(nextId)
+			if nextNode == nil {
+				continue
+			}
+
+			if nextNode.NodeType != VSMNodeDummy && keep(nextNode) {
+				found = append(found, nextNode)
+			}
+
+			queue = append(queue, nextNode)
+		}
+	}
+
+	return found
+}
+
+func parents(n *VSMNode) []string {
+	return n.Parents
+}
+
+func dependents(n *VSMNode) []string {
+	return n.Dependents
+}
+
+// UpstreamMaterials returns every material the node depends on, directly or through
+// upstream pipelines, e.g. UpstreamMaterials(m.CurrentPipeline).
+func (m *ValueStreamMap) UpstreamMaterials(id string) []*VSMNode {
+	return m.walk(id, parents, (*VSMNode).IsMaterial)
+}
+
+// UpstreamPipelines returns every pipeline the node depends on, directly or not.
+func (m *ValueStreamMap) UpstreamPipelines(id string) []*VSMNode {
+	return m.walk(id, parents, (*VSMNode).IsPipeline)
+}
+
+// DownstreamPipelines returns every pipeline depending on the node, directly or not.
+func (m *ValueStreamMap) DownstreamPipelines(id string) []*VSMNode {
+	return m.walk(id, dependents, (*VSMNode).IsPipeline)
+}